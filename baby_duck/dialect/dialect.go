@@ -0,0 +1,89 @@
+package dialect
+
+import (
+	"baby_duck/lexer"
+	"baby_duck/token"
+	"bytes"
+	"fmt"
+)
+
+// ------------------------------------------ DIALECTOS ------------------------------------------
+
+// Spanish: Palabras clave en español → palabra clave original
+var Spanish = map[string]string{
+	"programa":  "program",
+	"principal": "main",
+	"fin":       "end",
+	"mientras":  "while",
+	"hacer":     "do",
+	"si":        "if",
+	"sino":      "else",
+	"imprimir":  "print",
+	"entero":    "int",
+	"flotante":  "float",
+}
+
+// Dialects: Tablas disponibles por nombre (el de #lang)
+var Dialects = map[string]map[string]string{
+	"es": Spanish,
+}
+
+// langHeader: Primera línea que escoge el dialecto del archivo
+var langHeader = []byte("#lang ")
+
+// Scanner: Envuelve al lexer y cambia las palabras clave del dialecto por los tokens originales
+type Scanner struct {
+	lexer    *lexer.Lexer      // Lexer generado por gocc
+	keywords map[string]string // Palabra del dialecto → palabra clave original (nil = inglés)
+}
+
+// NewScanner: Crea el scanner, si el archivo empieza con "#lang es" usa ese dialecto
+// Regresa error si el dialecto no existe
+func NewScanner(src []byte) (*Scanner, error) {
+	keywords, src, err := Detect(src)
+	if err != nil {
+		return nil, err
+	}
+	return &Scanner{lexer: lexer.NewLexer(src), keywords: keywords}, nil
+}
+
+// NewScannerFor: Crea el scanner con un dialecto fijo (opción del lexer en lugar del encabezado)
+func NewScannerFor(src []byte, keywords map[string]string) *Scanner {
+	return &Scanner{lexer: lexer.NewLexer(src), keywords: keywords}
+}
+
+// Detect: Lee el encabezado #lang y lo borra con espacios (las líneas y columnas no cambian)
+func Detect(src []byte) (map[string]string, []byte, error) {
+	if !bytes.HasPrefix(src, langHeader) {
+		return nil, src, nil
+	}
+
+	end := bytes.IndexByte(src, '\n')
+	if end == -1 {
+		end = len(src)
+	}
+	name := string(bytes.TrimSpace(src[len(langHeader):end]))
+	keywords, exists := Dialects[name]
+	if !exists {
+		return nil, nil, fmt.Errorf("error: dialecto desconocido '%s' en #lang", name)
+	}
+
+	clean := append([]byte{}, src...)
+	for i := 0; i < end; i++ {
+		clean[i] = ' '
+	}
+	return keywords, clean, nil
+}
+
+// Scan: Siguiente token, un id que es palabra clave del dialecto se vuelve el token original
+func (s *Scanner) Scan() *token.Token {
+	tok := s.lexer.Scan()
+	if s.keywords == nil || tok.Type != token.TokMap.Type("id") {
+		return tok
+	}
+	if keyword, exists := s.keywords[string(tok.Lit)]; exists {
+		tok.Type = token.TokMap.Type(keyword)
+		tok.Lit = []byte(keyword)
+	}
+	return tok
+}
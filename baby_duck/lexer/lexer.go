@@ -11,8 +11,8 @@ import (
 
 const (
 	NoState    = -1
-	NumStates  = 79
-	NumSymbols = 113
+	NumStates  = 195
+	NumSymbols = 268
 )
 
 type Lexer struct {
@@ -140,106 +140,261 @@ Lexer symbols:
 8: 'a'
 9: 'i'
 10: 'n'
-11: 'e'
+11: 'i'
 12: 'n'
-13: 'd'
-14: 'v'
-15: 'a'
-16: 'r'
-17: 'i'
-18: 'n'
-19: 't'
-20: 'f'
-21: 'l'
-22: 'o'
-23: 'a'
-24: 't'
-25: 'p'
-26: 'r'
-27: 'i'
-28: 'n'
-29: 't'
-30: 'w'
-31: 'h'
-32: 'i'
-33: 'l'
-34: 'e'
-35: 'd'
+13: 'i'
+14: 't'
+15: 'f'
+16: 'u'
+17: 'n'
+18: 'c'
+19: 'r'
+20: 'e'
+21: 't'
+22: 'u'
+23: 'r'
+24: 'n'
+25: 'e'
+26: 'n'
+27: 'd'
+28: 'v'
+29: 'a'
+30: 'r'
+31: 'i'
+32: 'n'
+33: 't'
+34: 'f'
+35: 'l'
 36: 'o'
-37: 'i'
-38: 'f'
-39: 'e'
-40: 'l'
-41: 's'
-42: 'e'
-43: 'v'
-44: 'o'
-45: 'i'
-46: 'd'
-47: '_'
-48: '.'
-49: '"'
-50: '"'
-51: '='
-52: '!'
-53: '='
-54: '>'
-55: '<'
-56: '+'
-57: '-'
-58: '*'
-59: '/'
-60: ';'
-61: ':'
-62: ','
-63: '('
-64: ')'
-65: '{'
-66: '}'
-67: '['
-68: ']'
-69: 'e'
-70: 'm'
-71: 'p'
-72: 't'
-73: 'y'
-74: ' '
-75: '!'
-76: '#'
-77: '$'
-78: '%'
-79: '&'
-80: '''
-81: '('
-82: ')'
-83: '*'
-84: '+'
-85: ','
-86: '-'
-87: '.'
-88: '/'
-89: ':'
-90: ';'
-91: '<'
-92: '='
-93: '>'
-94: '?'
-95: '@'
-96: '['
-97: ']'
-98: '^'
-99: '_'
-100: '`'
-101: '{'
-102: '|'
-103: '}'
-104: '~'
-105: ' '
-106: '\t'
-107: '\n'
-108: '\r'
-109: 'a'-'z'
-110: 'A'-'Z'
-111: '0'-'9'
-112: .
+37: 'a'
+38: 't'
+39: 'x'
+40: 'o'
+41: 'r'
+42: 'l'
+43: 'i'
+44: 's'
+45: 't'
+46: 'o'
+47: 'f'
+48: 'f'
+49: 'o'
+50: 'r'
+51: 'i'
+52: 'n'
+53: 'b'
+54: 'i'
+55: 'g'
+56: 'i'
+57: 'n'
+58: 't'
+59: 's'
+60: 't'
+61: 'r'
+62: 'i'
+63: 'n'
+64: 'g'
+65: 'm'
+66: 'a'
+67: 'p'
+68: 's'
+69: 't'
+70: 'a'
+71: 'c'
+72: 'k'
+73: 'q'
+74: 'u'
+75: 'e'
+76: 'u'
+77: 'e'
+78: 'g'
+79: 'e'
+80: 'n'
+81: 'e'
+82: 'r'
+83: 'a'
+84: 't'
+85: 'o'
+86: 'r'
+87: 'y'
+88: 'i'
+89: 'e'
+90: 'l'
+91: 'd'
+92: 'r'
+93: 'e'
+94: 'q'
+95: 'u'
+96: 'i'
+97: 'r'
+98: 'e'
+99: 's'
+100: 'e'
+101: 'n'
+102: 's'
+103: 'u'
+104: 'r'
+105: 'e'
+106: 's'
+107: 'p'
+108: 'r'
+109: 'i'
+110: 'n'
+111: 't'
+112: 'w'
+113: 'r'
+114: 'i'
+115: 't'
+116: 'e'
+117: 'p'
+118: 'r'
+119: 'i'
+120: 'n'
+121: 't'
+122: 'f'
+123: 'w'
+124: 'h'
+125: 'i'
+126: 'l'
+127: 'e'
+128: 'd'
+129: 'o'
+130: 'i'
+131: 'f'
+132: 'e'
+133: 'l'
+134: 's'
+135: 'e'
+136: 'v'
+137: 'o'
+138: 'i'
+139: 'd'
+140: 'e'
+141: 'n'
+142: 'u'
+143: 'm'
+144: 'a'
+145: 's'
+146: 's'
+147: 'e'
+148: 'r'
+149: 't'
+150: 't'
+151: 'r'
+152: 'y'
+153: 'c'
+154: 'a'
+155: 't'
+156: 'c'
+157: 'h'
+158: 't'
+159: 'h'
+160: 'r'
+161: 'o'
+162: 'w'
+163: '_'
+164: 'n'
+165: '.'
+166: '"'
+167: '"'
+168: '='
+169: ':'
+170: '='
+171: '+'
+172: '='
+173: '-'
+174: '='
+175: '*'
+176: '='
+177: '/'
+178: '='
+179: '+'
+180: '+'
+181: '-'
+182: '-'
+183: '!'
+184: '='
+185: '='
+186: '='
+187: '<'
+188: '='
+189: '>'
+190: '='
+191: '<'
+192: '<'
+193: '>'
+194: '>'
+195: '&'
+196: '|'
+197: '~'
+198: '>'
+199: '<'
+200: '+'
+201: '-'
+202: '*'
+203: '/'
+204: ';'
+205: ':'
+206: '?'
+207: '.'
+208: '.'
+209: '.'
+210: '.'
+211: \u0001
+212: ','
+213: '('
+214: ')'
+215: '{'
+216: '}'
+217: '['
+218: ']'
+219: 'e'
+220: 'm'
+221: 'p'
+222: 't'
+223: 'y'
+224: ' '
+225: '!'
+226: '#'
+227: '$'
+228: '%'
+229: '&'
+230: '''
+231: '('
+232: ')'
+233: '*'
+234: '+'
+235: ','
+236: '-'
+237: '.'
+238: '/'
+239: ':'
+240: ';'
+241: '<'
+242: '='
+243: '>'
+244: '?'
+245: '@'
+246: '['
+247: ']'
+248: '^'
+249: '_'
+250: '`'
+251: '{'
+252: '|'
+253: '}'
+254: '~'
+255: '\'
+256: 'n'
+257: 't'
+258: '"'
+259: '\'
+260: ' '
+261: '\t'
+262: '\n'
+263: '\r'
+264: 'a'-'z'
+265: 'A'-'Z'
+266: '0'-'9'
+267: .
 */
@@ -13,82 +13,116 @@ var TransTab = TransitionTable{
 	// S0
 	func(r rune) int {
 		switch {
-		case r == 9: // ['\t','\t']
+		case r == 1: // [\u0001,\u0001]
 			return 1
+		case r == 9: // ['\t','\t']
+			return 2
 		case r == 10: // ['\n','\n']
-			return 1
+			return 2
 		case r == 13: // ['\r','\r']
-			return 1
+			return 2
 		case r == 32: // [' ',' ']
-			return 1
-		case r == 33: // ['!','!']
 			return 2
-		case r == 34: // ['"','"']
+		case r == 33: // ['!','!']
 			return 3
-		case r == 40: // ['(','(']
+		case r == 34: // ['"','"']
 			return 4
-		case r == 41: // [')',')']
+		case r == 38: // ['&','&']
 			return 5
-		case r == 42: // ['*','*']
+		case r == 40: // ['(','(']
 			return 6
-		case r == 43: // ['+','+']
+		case r == 41: // [')',')']
 			return 7
-		case r == 44: // [',',',']
+		case r == 42: // ['*','*']
 			return 8
-		case r == 45: // ['-','-']
+		case r == 43: // ['+','+']
 			return 9
-		case r == 47: // ['/','/']
+		case r == 44: // [',',',']
 			return 10
-		case 48 <= r && r <= 57: // ['0','9']
+		case r == 45: // ['-','-']
 			return 11
-		case r == 58: // [':',':']
+		case r == 46: // ['.','.']
 			return 12
-		case r == 59: // [';',';']
+		case r == 47: // ['/','/']
 			return 13
-		case r == 60: // ['<','<']
+		case 48 <= r && r <= 57: // ['0','9']
 			return 14
-		case r == 61: // ['=','=']
+		case r == 58: // [':',':']
 			return 15
-		case r == 62: // ['>','>']
+		case r == 59: // [';',';']
 			return 16
-		case 65 <= r && r <= 90: // ['A','Z']
+		case r == 60: // ['<','<']
 			return 17
-		case r == 91: // ['[','[']
+		case r == 61: // ['=','=']
 			return 18
-		case r == 93: // [']',']']
+		case r == 62: // ['>','>']
 			return 19
-		case 97 <= r && r <= 99: // ['a','c']
+		case r == 63: // ['?','?']
 			return 20
-		case r == 100: // ['d','d']
+		case 65 <= r && r <= 90: // ['A','Z']
 			return 21
-		case r == 101: // ['e','e']
+		case r == 91: // ['[','[']
 			return 22
-		case r == 102: // ['f','f']
+		case r == 93: // [']',']']
 			return 23
-		case 103 <= r && r <= 104: // ['g','h']
-			return 20
-		case r == 105: // ['i','i']
+		case r == 97: // ['a','a']
 			return 24
-		case 106 <= r && r <= 108: // ['j','l']
-			return 20
-		case r == 109: // ['m','m']
+		case r == 98: // ['b','b']
 			return 25
-		case 110 <= r && r <= 111: // ['n','o']
-			return 20
-		case r == 112: // ['p','p']
+		case r == 99: // ['c','c']
 			return 26
-		case 113 <= r && r <= 117: // ['q','u']
-			return 20
-		case r == 118: // ['v','v']
+		case r == 100: // ['d','d']
 			return 27
-		case r == 119: // ['w','w']
+		case r == 101: // ['e','e']
 			return 28
-		case 120 <= r && r <= 122: // ['x','z']
-			return 20
-		case r == 123: // ['{','{']
+		case r == 102: // ['f','f']
 			return 29
-		case r == 125: // ['}','}']
+		case r == 103: // ['g','g']
 			return 30
+		case r == 104: // ['h','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 32
+		case 106 <= r && r <= 107: // ['j','k']
+			return 31
+		case r == 108: // ['l','l']
+			return 33
+		case r == 109: // ['m','m']
+			return 34
+		case r == 110: // ['n','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 35
+		case r == 112: // ['p','p']
+			return 36
+		case r == 113: // ['q','q']
+			return 37
+		case r == 114: // ['r','r']
+			return 38
+		case r == 115: // ['s','s']
+			return 39
+		case r == 116: // ['t','t']
+			return 40
+		case r == 117: // ['u','u']
+			return 31
+		case r == 118: // ['v','v']
+			return 41
+		case r == 119: // ['w','w']
+			return 42
+		case r == 120: // ['x','x']
+			return 43
+		case r == 121: // ['y','y']
+			return 44
+		case r == 122: // ['z','z']
+			return 31
+		case r == 123: // ['{','{']
+			return 45
+		case r == 124: // ['|','|']
+			return 46
+		case r == 125: // ['}','}']
+			return 47
+		case r == 126: // ['~','~']
+			return 48
 		}
 		return NoState
 	},
@@ -101,90 +135,92 @@ var TransTab = TransitionTable{
 	// S2
 	func(r rune) int {
 		switch {
-		case r == 61: // ['=','=']
-			return 31
 		}
 		return NoState
 	},
 	// S3
+	func(r rune) int {
+		switch {
+		case r == 61: // ['=','=']
+			return 49
+		}
+		return NoState
+	},
+	// S4
 	func(r rune) int {
 		switch {
 		case r == 32: // [' ',' ']
-			return 32
+			return 50
 		case r == 33: // ['!','!']
-			return 32
+			return 50
 		case r == 34: // ['"','"']
-			return 33
+			return 51
 		case r == 35: // ['#','#']
-			return 32
+			return 50
 		case r == 36: // ['$','$']
-			return 32
+			return 50
 		case r == 37: // ['%','%']
-			return 32
+			return 50
 		case r == 38: // ['&','&']
-			return 32
+			return 50
 		case r == 39: // [''',''']
-			return 32
+			return 50
 		case r == 40: // ['(','(']
-			return 32
+			return 50
 		case r == 41: // [')',')']
-			return 32
+			return 50
 		case r == 42: // ['*','*']
-			return 32
+			return 50
 		case r == 43: // ['+','+']
-			return 32
+			return 50
 		case r == 44: // [',',',']
-			return 32
+			return 50
 		case r == 45: // ['-','-']
-			return 32
+			return 50
 		case r == 46: // ['.','.']
-			return 32
+			return 50
 		case r == 47: // ['/','/']
-			return 32
+			return 50
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
+			return 52
 		case r == 58: // [':',':']
-			return 32
+			return 50
 		case r == 59: // [';',';']
-			return 32
+			return 50
 		case r == 60: // ['<','<']
-			return 32
+			return 50
 		case r == 61: // ['=','=']
-			return 32
+			return 50
 		case r == 62: // ['>','>']
-			return 32
+			return 50
 		case r == 63: // ['?','?']
-			return 32
+			return 50
 		case r == 64: // ['@','@']
-			return 32
+			return 50
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 35
+			return 53
 		case r == 91: // ['[','[']
-			return 32
+			return 50
+		case r == 92: // ['\','\']
+			return 54
 		case r == 93: // [']',']']
-			return 32
+			return 50
 		case r == 94: // ['^','^']
-			return 32
+			return 50
 		case r == 95: // ['_','_']
-			return 32
+			return 50
 		case r == 96: // ['`','`']
-			return 32
+			return 50
 		case 97 <= r && r <= 122: // ['a','z']
-			return 36
+			return 55
 		case r == 123: // ['{','{']
-			return 32
+			return 50
 		case r == 124: // ['|','|']
-			return 32
+			return 50
 		case r == 125: // ['}','}']
-			return 32
+			return 50
 		case r == 126: // ['~','~']
-			return 32
-		}
-		return NoState
-	},
-	// S4
-	func(r rune) int {
-		switch {
+			return 50
 		}
 		return NoState
 	},
@@ -209,12 +245,18 @@ var TransTab = TransitionTable{
 	// S8
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 56
 		}
 		return NoState
 	},
 	// S9
 	func(r rune) int {
 		switch {
+		case r == 43: // ['+','+']
+			return 57
+		case r == 61: // ['=','=']
+			return 58
 		}
 		return NoState
 	},
@@ -227,34 +269,46 @@ var TransTab = TransitionTable{
 	// S11
 	func(r rune) int {
 		switch {
-		case r == 46: // ['.','.']
-			return 37
-		case 48 <= r && r <= 57: // ['0','9']
-			return 11
+		case r == 45: // ['-','-']
+			return 59
+		case r == 61: // ['=','=']
+			return 60
 		}
 		return NoState
 	},
 	// S12
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 61
 		}
 		return NoState
 	},
 	// S13
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 62
 		}
 		return NoState
 	},
 	// S14
 	func(r rune) int {
 		switch {
+		case r == 46: // ['.','.']
+			return 63
+		case 48 <= r && r <= 57: // ['0','9']
+			return 14
+		case r == 110: // ['n','n']
+			return 64
 		}
 		return NoState
 	},
 	// S15
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 65
 		}
 		return NoState
 	},
@@ -267,40 +321,34 @@ var TransTab = TransitionTable{
 	// S17
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 38
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
-		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+		case r == 60: // ['<','<']
+			return 66
+		case r == 61: // ['=','=']
+			return 67
 		}
 		return NoState
 	},
 	// S18
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 68
 		}
 		return NoState
 	},
 	// S19
 	func(r rune) int {
 		switch {
+		case r == 61: // ['=','=']
+			return 69
+		case r == 62: // ['>','>']
+			return 70
 		}
 		return NoState
 	},
 	// S20
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 38
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
-		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
 		}
 		return NoState
 	},
@@ -308,57 +356,25 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 110: // ['a','n']
-			return 20
-		case r == 111: // ['o','o']
-			return 40
-		case 112 <= r && r <= 122: // ['p','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
 	// S22
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 38
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
-		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 107: // ['a','k']
-			return 20
-		case r == 108: // ['l','l']
-			return 41
-		case r == 109: // ['m','m']
-			return 42
-		case r == 110: // ['n','n']
-			return 43
-		case 111 <= r && r <= 122: // ['o','z']
-			return 20
 		}
 		return NoState
 	},
 	// S23
 	func(r rune) int {
 		switch {
-		case 48 <= r && r <= 57: // ['0','9']
-			return 38
-		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
-		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 107: // ['a','k']
-			return 20
-		case r == 108: // ['l','l']
-			return 44
-		case 109 <= r && r <= 122: // ['m','z']
-			return 20
 		}
 		return NoState
 	},
@@ -366,21 +382,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 101: // ['a','e']
-			return 20
-		case r == 102: // ['f','f']
-			return 45
-		case 103 <= r && r <= 109: // ['g','m']
-			return 20
-		case r == 110: // ['n','n']
-			return 46
-		case 111 <= r && r <= 122: // ['o','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 73
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
 		}
 		return NoState
 	},
@@ -388,15 +400,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case r == 97: // ['a','a']
-			return 47
-		case 98 <= r && r <= 122: // ['b','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 74
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
 		}
 		return NoState
 	},
@@ -404,17 +418,15 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 113: // ['a','q']
-			return 20
-		case r == 114: // ['r','r']
-			return 48
-		case 115 <= r && r <= 122: // ['s','z']
-			return 20
+			return 72
+		case r == 97: // ['a','a']
+			return 75
+		case 98 <= r && r <= 122: // ['b','z']
+			return 31
 		}
 		return NoState
 	},
@@ -422,19 +434,17 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case r == 97: // ['a','a']
-			return 49
-		case 98 <= r && r <= 110: // ['b','n']
-			return 20
+			return 72
+		case 97 <= r && r <= 110: // ['a','n']
+			return 31
 		case r == 111: // ['o','o']
-			return 50
+			return 76
 		case 112 <= r && r <= 122: // ['p','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
@@ -442,1021 +452,2923 @@ var TransTab = TransitionTable{
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 103: // ['a','g']
-			return 20
-		case r == 104: // ['h','h']
-			return 51
-		case 105 <= r && r <= 122: // ['i','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 107: // ['a','k']
+			return 31
+		case r == 108: // ['l','l']
+			return 77
+		case r == 109: // ['m','m']
+			return 78
+		case r == 110: // ['n','n']
+			return 79
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
 		}
 		return NoState
 	},
 	// S29
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 107: // ['a','k']
+			return 31
+		case r == 108: // ['l','l']
+			return 80
+		case 109 <= r && r <= 110: // ['m','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 81
+		case 112 <= r && r <= 116: // ['p','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 82
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
 		}
 		return NoState
 	},
 	// S30
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 83
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
 	// S31
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
 	// S32
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 32
-		case r == 33: // ['!','!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case r == 35: // ['#','#']
-			return 32
-		case r == 36: // ['$','$']
-			return 32
-		case r == 37: // ['%','%']
-			return 32
-		case r == 38: // ['&','&']
-			return 32
-		case r == 39: // [''',''']
-			return 32
-		case r == 40: // ['(','(']
-			return 32
-		case r == 41: // [')',')']
-			return 32
-		case r == 42: // ['*','*']
-			return 32
-		case r == 43: // ['+','+']
-			return 32
-		case r == 44: // [',',',']
-			return 32
-		case r == 45: // ['-','-']
-			return 32
-		case r == 46: // ['.','.']
-			return 32
-		case r == 47: // ['/','/']
-			return 32
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
-		case r == 58: // [':',':']
-			return 32
-		case r == 59: // [';',';']
-			return 32
-		case r == 60: // ['<','<']
-			return 32
-		case r == 61: // ['=','=']
-			return 32
-		case r == 62: // ['>','>']
-			return 32
-		case r == 63: // ['?','?']
-			return 32
-		case r == 64: // ['@','@']
-			return 32
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 35
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
-		case r == 94: // ['^','^']
-			return 32
+			return 21
 		case r == 95: // ['_','_']
-			return 32
-		case r == 96: // ['`','`']
-			return 32
-		case 97 <= r && r <= 122: // ['a','z']
-			return 36
-		case r == 123: // ['{','{']
-			return 32
-		case r == 124: // ['|','|']
-			return 32
-		case r == 125: // ['}','}']
-			return 32
-		case r == 126: // ['~','~']
-			return 32
+			return 72
+		case 97 <= r && r <= 101: // ['a','e']
+			return 31
+		case r == 102: // ['f','f']
+			return 84
+		case 103 <= r && r <= 109: // ['g','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 85
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
 		}
 		return NoState
 	},
 	// S33
 	func(r rune) int {
 		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 86
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
 		}
 		return NoState
 	},
 	// S34
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 32
-		case r == 33: // ['!','!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case r == 35: // ['#','#']
-			return 32
-		case r == 36: // ['$','$']
-			return 32
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case r == 97: // ['a','a']
+			return 87
+		case 98 <= r && r <= 122: // ['b','z']
+			return 31
+		}
+		return NoState
+	},
+	// S35
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 101: // ['a','e']
+			return 31
+		case r == 102: // ['f','f']
+			return 88
+		case 103 <= r && r <= 122: // ['g','z']
+			return 31
+		}
+		return NoState
+	},
+	// S36
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 89
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S37
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 116: // ['a','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 90
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S38
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 91
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S39
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 92
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S40
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 103: // ['a','g']
+			return 31
+		case r == 104: // ['h','h']
+			return 93
+		case 105 <= r && r <= 113: // ['i','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 94
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S41
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case r == 97: // ['a','a']
+			return 95
+		case 98 <= r && r <= 110: // ['b','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 96
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
+		}
+		return NoState
+	},
+	// S42
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 103: // ['a','g']
+			return 31
+		case r == 104: // ['h','h']
+			return 97
+		case 105 <= r && r <= 113: // ['i','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 98
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S43
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 110: // ['a','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 99
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
+		}
+		return NoState
+	},
+	// S44
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 100
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S45
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S46
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S47
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S48
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S49
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S50
+	func(r rune) int {
+		switch {
+		case r == 32: // [' ',' ']
+			return 50
+		case r == 33: // ['!','!']
+			return 50
+		case r == 34: // ['"','"']
+			return 51
+		case r == 35: // ['#','#']
+			return 50
+		case r == 36: // ['$','$']
+			return 50
 		case r == 37: // ['%','%']
-			return 32
+			return 50
 		case r == 38: // ['&','&']
-			return 32
+			return 50
 		case r == 39: // [''',''']
-			return 32
+			return 50
 		case r == 40: // ['(','(']
-			return 32
+			return 50
 		case r == 41: // [')',')']
-			return 32
+			return 50
 		case r == 42: // ['*','*']
-			return 32
+			return 50
 		case r == 43: // ['+','+']
-			return 32
+			return 50
 		case r == 44: // [',',',']
-			return 32
+			return 50
 		case r == 45: // ['-','-']
-			return 32
+			return 50
 		case r == 46: // ['.','.']
-			return 32
+			return 50
 		case r == 47: // ['/','/']
-			return 32
+			return 50
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
+			return 52
 		case r == 58: // [':',':']
-			return 32
+			return 50
 		case r == 59: // [';',';']
-			return 32
+			return 50
 		case r == 60: // ['<','<']
-			return 32
+			return 50
 		case r == 61: // ['=','=']
-			return 32
+			return 50
 		case r == 62: // ['>','>']
-			return 32
+			return 50
 		case r == 63: // ['?','?']
-			return 32
+			return 50
 		case r == 64: // ['@','@']
-			return 32
+			return 50
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 35
+			return 53
 		case r == 91: // ['[','[']
-			return 32
+			return 50
+		case r == 92: // ['\','\']
+			return 54
 		case r == 93: // [']',']']
-			return 32
+			return 50
 		case r == 94: // ['^','^']
-			return 32
+			return 50
 		case r == 95: // ['_','_']
-			return 32
+			return 50
 		case r == 96: // ['`','`']
-			return 32
+			return 50
 		case 97 <= r && r <= 122: // ['a','z']
-			return 36
+			return 55
 		case r == 123: // ['{','{']
-			return 32
+			return 50
 		case r == 124: // ['|','|']
-			return 32
+			return 50
 		case r == 125: // ['}','}']
-			return 32
+			return 50
 		case r == 126: // ['~','~']
-			return 32
+			return 50
+		}
+		return NoState
+	},
+	// S51
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S52
+	func(r rune) int {
+		switch {
+		case r == 32: // [' ',' ']
+			return 50
+		case r == 33: // ['!','!']
+			return 50
+		case r == 34: // ['"','"']
+			return 51
+		case r == 35: // ['#','#']
+			return 50
+		case r == 36: // ['$','$']
+			return 50
+		case r == 37: // ['%','%']
+			return 50
+		case r == 38: // ['&','&']
+			return 50
+		case r == 39: // [''',''']
+			return 50
+		case r == 40: // ['(','(']
+			return 50
+		case r == 41: // [')',')']
+			return 50
+		case r == 42: // ['*','*']
+			return 50
+		case r == 43: // ['+','+']
+			return 50
+		case r == 44: // [',',',']
+			return 50
+		case r == 45: // ['-','-']
+			return 50
+		case r == 46: // ['.','.']
+			return 50
+		case r == 47: // ['/','/']
+			return 50
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case r == 58: // [':',':']
+			return 50
+		case r == 59: // [';',';']
+			return 50
+		case r == 60: // ['<','<']
+			return 50
+		case r == 61: // ['=','=']
+			return 50
+		case r == 62: // ['>','>']
+			return 50
+		case r == 63: // ['?','?']
+			return 50
+		case r == 64: // ['@','@']
+			return 50
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 53
+		case r == 91: // ['[','[']
+			return 50
+		case r == 92: // ['\','\']
+			return 54
+		case r == 93: // [']',']']
+			return 50
+		case r == 94: // ['^','^']
+			return 50
+		case r == 95: // ['_','_']
+			return 50
+		case r == 96: // ['`','`']
+			return 50
+		case 97 <= r && r <= 122: // ['a','z']
+			return 55
+		case r == 123: // ['{','{']
+			return 50
+		case r == 124: // ['|','|']
+			return 50
+		case r == 125: // ['}','}']
+			return 50
+		case r == 126: // ['~','~']
+			return 50
+		}
+		return NoState
+	},
+	// S53
+	func(r rune) int {
+		switch {
+		case r == 32: // [' ',' ']
+			return 50
+		case r == 33: // ['!','!']
+			return 50
+		case r == 34: // ['"','"']
+			return 51
+		case r == 35: // ['#','#']
+			return 50
+		case r == 36: // ['$','$']
+			return 50
+		case r == 37: // ['%','%']
+			return 50
+		case r == 38: // ['&','&']
+			return 50
+		case r == 39: // [''',''']
+			return 50
+		case r == 40: // ['(','(']
+			return 50
+		case r == 41: // [')',')']
+			return 50
+		case r == 42: // ['*','*']
+			return 50
+		case r == 43: // ['+','+']
+			return 50
+		case r == 44: // [',',',']
+			return 50
+		case r == 45: // ['-','-']
+			return 50
+		case r == 46: // ['.','.']
+			return 50
+		case r == 47: // ['/','/']
+			return 50
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case r == 58: // [':',':']
+			return 50
+		case r == 59: // [';',';']
+			return 50
+		case r == 60: // ['<','<']
+			return 50
+		case r == 61: // ['=','=']
+			return 50
+		case r == 62: // ['>','>']
+			return 50
+		case r == 63: // ['?','?']
+			return 50
+		case r == 64: // ['@','@']
+			return 50
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 53
+		case r == 91: // ['[','[']
+			return 50
+		case r == 92: // ['\','\']
+			return 54
+		case r == 93: // [']',']']
+			return 50
+		case r == 94: // ['^','^']
+			return 50
+		case r == 95: // ['_','_']
+			return 50
+		case r == 96: // ['`','`']
+			return 50
+		case 97 <= r && r <= 122: // ['a','z']
+			return 55
+		case r == 123: // ['{','{']
+			return 50
+		case r == 124: // ['|','|']
+			return 50
+		case r == 125: // ['}','}']
+			return 50
+		case r == 126: // ['~','~']
+			return 50
+		}
+		return NoState
+	},
+	// S54
+	func(r rune) int {
+		switch {
+		case r == 34: // ['"','"']
+			return 101
+		case r == 92: // ['\','\']
+			return 101
+		case r == 110: // ['n','n']
+			return 101
+		case r == 116: // ['t','t']
+			return 101
+		}
+		return NoState
+	},
+	// S55
+	func(r rune) int {
+		switch {
+		case r == 32: // [' ',' ']
+			return 50
+		case r == 33: // ['!','!']
+			return 50
+		case r == 34: // ['"','"']
+			return 51
+		case r == 35: // ['#','#']
+			return 50
+		case r == 36: // ['$','$']
+			return 50
+		case r == 37: // ['%','%']
+			return 50
+		case r == 38: // ['&','&']
+			return 50
+		case r == 39: // [''',''']
+			return 50
+		case r == 40: // ['(','(']
+			return 50
+		case r == 41: // [')',')']
+			return 50
+		case r == 42: // ['*','*']
+			return 50
+		case r == 43: // ['+','+']
+			return 50
+		case r == 44: // [',',',']
+			return 50
+		case r == 45: // ['-','-']
+			return 50
+		case r == 46: // ['.','.']
+			return 50
+		case r == 47: // ['/','/']
+			return 50
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case r == 58: // [':',':']
+			return 50
+		case r == 59: // [';',';']
+			return 50
+		case r == 60: // ['<','<']
+			return 50
+		case r == 61: // ['=','=']
+			return 50
+		case r == 62: // ['>','>']
+			return 50
+		case r == 63: // ['?','?']
+			return 50
+		case r == 64: // ['@','@']
+			return 50
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 53
+		case r == 91: // ['[','[']
+			return 50
+		case r == 92: // ['\','\']
+			return 54
+		case r == 93: // [']',']']
+			return 50
+		case r == 94: // ['^','^']
+			return 50
+		case r == 95: // ['_','_']
+			return 50
+		case r == 96: // ['`','`']
+			return 50
+		case 97 <= r && r <= 122: // ['a','z']
+			return 55
+		case r == 123: // ['{','{']
+			return 50
+		case r == 124: // ['|','|']
+			return 50
+		case r == 125: // ['}','}']
+			return 50
+		case r == 126: // ['~','~']
+			return 50
+		}
+		return NoState
+	},
+	// S56
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S57
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S58
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S59
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S60
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S61
+	func(r rune) int {
+		switch {
+		case r == 46: // ['.','.']
+			return 102
+		}
+		return NoState
+	},
+	// S62
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S63
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 103
+		}
+		return NoState
+	},
+	// S64
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S65
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S66
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S67
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S68
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S69
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S70
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S71
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S72
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S73
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 104
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
+		}
+		return NoState
+	},
+	// S74
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 102: // ['a','f']
+			return 31
+		case r == 103: // ['g','g']
+			return 105
+		case 104 <= r && r <= 122: // ['h','z']
+			return 31
+		}
+		return NoState
+	},
+	// S75
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 106
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S76
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S77
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 107
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
+		}
+		return NoState
+	},
+	// S78
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 111: // ['a','o']
+			return 31
+		case r == 112: // ['p','p']
+			return 108
+		case 113 <= r && r <= 122: // ['q','z']
+			return 31
+		}
+		return NoState
+	},
+	// S79
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 99: // ['a','c']
+			return 31
+		case r == 100: // ['d','d']
+			return 109
+		case 101 <= r && r <= 114: // ['e','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 110
+		case r == 116: // ['t','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 111
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S80
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 110: // ['a','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 112
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
+		}
+		return NoState
+	},
+	// S81
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 113
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S82
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 114
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
+		}
+		return NoState
+	},
+	// S83
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 115
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
+		}
+		return NoState
+	},
+	// S84
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S85
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 116
+		case 106 <= r && r <= 115: // ['j','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 117
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S86
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 118
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
+		}
+		return NoState
+	},
+	// S87
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 119
+		case 106 <= r && r <= 111: // ['j','o']
+			return 31
+		case r == 112: // ['p','p']
+			return 120
+		case 113 <= r && r <= 122: // ['q','z']
+			return 31
+		}
+		return NoState
+	},
+	// S88
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S89
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 121
+		case 106 <= r && r <= 110: // ['j','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 122
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
+		}
+		return NoState
+	},
+	// S90
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 123
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S91
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 112: // ['a','p']
+			return 31
+		case r == 113: // ['q','q']
+			return 124
+		case 114 <= r && r <= 115: // ['r','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 125
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S92
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case r == 97: // ['a','a']
+			return 126
+		case 98 <= r && r <= 113: // ['b','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 127
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S93
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 128
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S94
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 120: // ['a','x']
+			return 31
+		case r == 121: // ['y','y']
+			return 129
+		case r == 122: // ['z','z']
+			return 31
+		}
+		return NoState
+	},
+	// S95
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 130
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S96
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 131
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S97
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 132
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S98
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 133
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S99
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 134
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S100
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 135
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S101
+	func(r rune) int {
+		switch {
+		case r == 32: // [' ',' ']
+			return 50
+		case r == 33: // ['!','!']
+			return 50
+		case r == 34: // ['"','"']
+			return 51
+		case r == 35: // ['#','#']
+			return 50
+		case r == 36: // ['$','$']
+			return 50
+		case r == 37: // ['%','%']
+			return 50
+		case r == 38: // ['&','&']
+			return 50
+		case r == 39: // [''',''']
+			return 50
+		case r == 40: // ['(','(']
+			return 50
+		case r == 41: // [')',')']
+			return 50
+		case r == 42: // ['*','*']
+			return 50
+		case r == 43: // ['+','+']
+			return 50
+		case r == 44: // [',',',']
+			return 50
+		case r == 45: // ['-','-']
+			return 50
+		case r == 46: // ['.','.']
+			return 50
+		case r == 47: // ['/','/']
+			return 50
+		case 48 <= r && r <= 57: // ['0','9']
+			return 52
+		case r == 58: // [':',':']
+			return 50
+		case r == 59: // [';',';']
+			return 50
+		case r == 60: // ['<','<']
+			return 50
+		case r == 61: // ['=','=']
+			return 50
+		case r == 62: // ['>','>']
+			return 50
+		case r == 63: // ['?','?']
+			return 50
+		case r == 64: // ['@','@']
+			return 50
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 53
+		case r == 91: // ['[','[']
+			return 50
+		case r == 92: // ['\','\']
+			return 54
+		case r == 93: // [']',']']
+			return 50
+		case r == 94: // ['^','^']
+			return 50
+		case r == 95: // ['_','_']
+			return 50
+		case r == 96: // ['`','`']
+			return 50
+		case 97 <= r && r <= 122: // ['a','z']
+			return 55
+		case r == 123: // ['{','{']
+			return 50
+		case r == 124: // ['|','|']
+			return 50
+		case r == 125: // ['}','}']
+			return 50
+		case r == 126: // ['~','~']
+			return 50
+		}
+		return NoState
+	},
+	// S102
+	func(r rune) int {
+		switch {
+		}
+		return NoState
+	},
+	// S103
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 103
+		}
+		return NoState
+	},
+	// S104
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 136
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S105
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 137
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S106
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 98: // ['a','b']
+			return 31
+		case r == 99: // ['c','c']
+			return 138
+		case 100 <= r && r <= 122: // ['d','z']
+			return 31
+		}
+		return NoState
+	},
+	// S107
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 139
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S108
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 140
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S109
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S110
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 116: // ['a','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 141
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S111
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 108: // ['a','l']
+			return 31
+		case r == 109: // ['m','m']
+			return 142
+		case 110 <= r && r <= 122: // ['n','z']
+			return 31
+		}
+		return NoState
+	},
+	// S112
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case r == 97: // ['a','a']
+			return 143
+		case 98 <= r && r <= 122: // ['b','z']
+			return 31
+		}
+		return NoState
+	},
+	// S113
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S114
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 98: // ['a','b']
+			return 31
+		case r == 99: // ['c','c']
+			return 144
+		case 100 <= r && r <= 122: // ['d','z']
+			return 31
+		}
+		return NoState
+	},
+	// S115
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 145
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
+		}
+		return NoState
+	},
+	// S116
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 146
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S117
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S118
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 147
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S119
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 148
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
+		}
+		return NoState
+	},
+	// S120
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S121
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 149
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
+		}
+		return NoState
+	},
+	// S122
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 102: // ['a','f']
+			return 31
+		case r == 103: // ['g','g']
+			return 150
+		case 104 <= r && r <= 122: // ['h','z']
+			return 31
+		}
+		return NoState
+	},
+	// S123
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 116: // ['a','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 151
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S124
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 116: // ['a','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 152
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S125
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 116: // ['a','t']
+			return 31
+		case r == 117: // ['u','u']
+			return 153
+		case 118 <= r && r <= 122: // ['v','z']
+			return 31
+		}
+		return NoState
+	},
+	// S126
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 98: // ['a','b']
+			return 31
+		case r == 99: // ['c','c']
+			return 154
+		case 100 <= r && r <= 122: // ['d','z']
+			return 31
+		}
+		return NoState
+	},
+	// S127
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 155
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
+		}
+		return NoState
+	},
+	// S128
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 110: // ['a','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 156
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
+		}
+		return NoState
+	},
+	// S129
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S130
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S131
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 99: // ['a','c']
+			return 31
+		case r == 100: // ['d','d']
+			return 157
+		case 101 <= r && r <= 122: // ['e','z']
+			return 31
+		}
+		return NoState
+	},
+	// S132
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 107: // ['a','k']
+			return 31
+		case r == 108: // ['l','l']
+			return 158
+		case 109 <= r && r <= 122: // ['m','z']
+			return 31
+		}
+		return NoState
+	},
+	// S133
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 159
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S134
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S135
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 107: // ['a','k']
+			return 31
+		case r == 108: // ['l','l']
+			return 160
+		case 109 <= r && r <= 122: // ['m','z']
+			return 31
+		}
+		return NoState
+	},
+	// S136
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 161
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S137
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 162
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
+		}
+		return NoState
+	},
+	// S138
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 103: // ['a','g']
+			return 31
+		case r == 104: // ['h','h']
+			return 163
+		case 105 <= r && r <= 122: // ['i','z']
+			return 31
+		}
+		return NoState
+	},
+	// S139
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S140
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 120: // ['a','x']
+			return 31
+		case r == 121: // ['y','y']
+			return 164
+		case r == 122: // ['z','z']
+			return 31
+		}
+		return NoState
+	},
+	// S141
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 165
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S142
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S143
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 166
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
+		}
+		return NoState
+	},
+	// S144
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S145
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 167
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
+		}
+		return NoState
+	},
+	// S146
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S147
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S148
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S149
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 168
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
 		}
 		return NoState
 	},
-	// S35
+	// S150
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 32
-		case r == 33: // ['!','!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case r == 35: // ['#','#']
-			return 32
-		case r == 36: // ['$','$']
-			return 32
-		case r == 37: // ['%','%']
-			return 32
-		case r == 38: // ['&','&']
-			return 32
-		case r == 39: // [''',''']
-			return 32
-		case r == 40: // ['(','(']
-			return 32
-		case r == 41: // [')',')']
-			return 32
-		case r == 42: // ['*','*']
-			return 32
-		case r == 43: // ['+','+']
-			return 32
-		case r == 44: // [',',',']
-			return 32
-		case r == 45: // ['-','-']
-			return 32
-		case r == 46: // ['.','.']
-			return 32
-		case r == 47: // ['/','/']
-			return 32
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
-		case r == 58: // [':',':']
-			return 32
-		case r == 59: // [';',';']
-			return 32
-		case r == 60: // ['<','<']
-			return 32
-		case r == 61: // ['=','=']
-			return 32
-		case r == 62: // ['>','>']
-			return 32
-		case r == 63: // ['?','?']
-			return 32
-		case r == 64: // ['@','@']
-			return 32
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 35
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
-		case r == 94: // ['^','^']
-			return 32
+			return 21
 		case r == 95: // ['_','_']
-			return 32
-		case r == 96: // ['`','`']
-			return 32
-		case 97 <= r && r <= 122: // ['a','z']
-			return 36
-		case r == 123: // ['{','{']
-			return 32
-		case r == 124: // ['|','|']
-			return 32
-		case r == 125: // ['}','}']
-			return 32
-		case r == 126: // ['~','~']
-			return 32
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 169
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
 		}
 		return NoState
 	},
-	// S36
+	// S151
 	func(r rune) int {
 		switch {
-		case r == 32: // [' ',' ']
-			return 32
-		case r == 33: // ['!','!']
-			return 32
-		case r == 34: // ['"','"']
-			return 33
-		case r == 35: // ['#','#']
-			return 32
-		case r == 36: // ['$','$']
-			return 32
-		case r == 37: // ['%','%']
-			return 32
-		case r == 38: // ['&','&']
-			return 32
-		case r == 39: // [''',''']
-			return 32
-		case r == 40: // ['(','(']
-			return 32
-		case r == 41: // [')',')']
-			return 32
-		case r == 42: // ['*','*']
-			return 32
-		case r == 43: // ['+','+']
-			return 32
-		case r == 44: // [',',',']
-			return 32
-		case r == 45: // ['-','-']
-			return 32
-		case r == 46: // ['.','.']
-			return 32
-		case r == 47: // ['/','/']
-			return 32
 		case 48 <= r && r <= 57: // ['0','9']
-			return 34
-		case r == 58: // [':',':']
-			return 32
-		case r == 59: // [';',';']
-			return 32
-		case r == 60: // ['<','<']
-			return 32
-		case r == 61: // ['=','=']
-			return 32
-		case r == 62: // ['>','>']
-			return 32
-		case r == 63: // ['?','?']
-			return 32
-		case r == 64: // ['@','@']
-			return 32
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 35
-		case r == 91: // ['[','[']
-			return 32
-		case r == 93: // [']',']']
-			return 32
-		case r == 94: // ['^','^']
-			return 32
+			return 21
 		case r == 95: // ['_','_']
-			return 32
-		case r == 96: // ['`','`']
-			return 32
-		case 97 <= r && r <= 122: // ['a','z']
-			return 36
-		case r == 123: // ['{','{']
-			return 32
-		case r == 124: // ['|','|']
-			return 32
-		case r == 125: // ['}','}']
-			return 32
-		case r == 126: // ['~','~']
-			return 32
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 170
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
-	// S37
+	// S152
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 104: // ['a','h']
+			return 31
+		case r == 105: // ['i','i']
+			return 171
+		case 106 <= r && r <= 122: // ['j','z']
+			return 31
 		}
 		return NoState
 	},
-	// S38
+	// S153
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 172
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
 		}
 		return NoState
 	},
-	// S39
+	// S154
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 106: // ['a','j']
+			return 31
+		case r == 107: // ['k','k']
+			return 173
+		case 108 <= r && r <= 122: // ['l','z']
+			return 31
 		}
 		return NoState
 	},
-	// S40
+	// S155
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 174
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
 		}
 		return NoState
 	},
-	// S41
+	// S156
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 114: // ['a','r']
-			return 20
-		case r == 115: // ['s','s']
-			return 53
-		case 116 <= r && r <= 122: // ['t','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 118: // ['a','v']
+			return 31
+		case r == 119: // ['w','w']
+			return 175
+		case 120 <= r && r <= 122: // ['x','z']
+			return 31
 		}
 		return NoState
 	},
-	// S42
+	// S157
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 111: // ['a','o']
-			return 20
-		case r == 112: // ['p','p']
-			return 54
-		case 113 <= r && r <= 122: // ['q','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
+		}
+		return NoState
+	},
+	// S158
+	func(r rune) int {
+		switch {
+		case 48 <= r && r <= 57: // ['0','9']
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 176
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
-	// S43
+	// S159
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 99: // ['a','c']
-			return 20
-		case r == 100: // ['d','d']
-			return 55
-		case 101 <= r && r <= 122: // ['e','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 177
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
-	// S44
+	// S160
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 110: // ['a','n']
-			return 20
-		case r == 111: // ['o','o']
-			return 56
-		case 112 <= r && r <= 122: // ['p','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 99: // ['a','c']
+			return 31
+		case r == 100: // ['d','d']
+			return 178
+		case 101 <= r && r <= 122: // ['e','z']
+			return 31
 		}
 		return NoState
 	},
-	// S45
+	// S161
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 115: // ['a','s']
+			return 31
+		case r == 116: // ['t','t']
+			return 179
+		case 117 <= r && r <= 122: // ['u','z']
+			return 31
 		}
 		return NoState
 	},
-	// S46
+	// S162
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 115: // ['a','s']
-			return 20
+			return 31
 		case r == 116: // ['t','t']
-			return 57
+			return 180
 		case 117 <= r && r <= 122: // ['u','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S47
+	// S163
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 104: // ['a','h']
-			return 20
-		case r == 105: // ['i','i']
-			return 58
-		case 106 <= r && r <= 122: // ['j','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S48
+	// S164
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 104: // ['a','h']
-			return 20
-		case r == 105: // ['i','i']
-			return 59
-		case 106 <= r && r <= 110: // ['j','n']
-			return 20
-		case r == 111: // ['o','o']
-			return 60
-		case 112 <= r && r <= 122: // ['p','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S49
+	// S165
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 113: // ['a','q']
-			return 20
-		case r == 114: // ['r','r']
-			return 61
-		case 115 <= r && r <= 122: // ['s','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 181
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
-	// S50
+	// S166
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 104: // ['a','h']
-			return 20
-		case r == 105: // ['i','i']
-			return 62
-		case 106 <= r && r <= 122: // ['j','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S51
+	// S167
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 104: // ['a','h']
-			return 20
-		case r == 105: // ['i','i']
-			return 63
-		case 106 <= r && r <= 122: // ['j','z']
-			return 20
+			return 72
+		case r == 97: // ['a','a']
+			return 182
+		case 98 <= r && r <= 122: // ['b','z']
+			return 31
 		}
 		return NoState
 	},
-	// S52
+	// S168
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 52
+			return 71
+		case 65 <= r && r <= 90: // ['A','Z']
+			return 21
+		case r == 95: // ['_','_']
+			return 72
+		case 97 <= r && r <= 101: // ['a','e']
+			return 31
+		case r == 102: // ['f','f']
+			return 183
+		case 103 <= r && r <= 122: // ['g','z']
+			return 31
 		}
 		return NoState
 	},
-	// S53
+	// S169
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 100: // ['a','d']
-			return 20
-		case r == 101: // ['e','e']
-			return 64
-		case 102 <= r && r <= 122: // ['f','z']
-			return 20
+			return 72
+		case r == 97: // ['a','a']
+			return 184
+		case 98 <= r && r <= 122: // ['b','z']
+			return 31
 		}
 		return NoState
 	},
-	// S54
+	// S170
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 115: // ['a','s']
-			return 20
-		case r == 116: // ['t','t']
-			return 65
-		case 117 <= r && r <= 122: // ['u','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S55
+	// S171
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 185
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
 		}
 		return NoState
 	},
-	// S56
+	// S172
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case r == 97: // ['a','a']
-			return 66
-		case 98 <= r && r <= 122: // ['b','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 109: // ['a','m']
+			return 31
+		case r == 110: // ['n','n']
+			return 186
+		case 111 <= r && r <= 122: // ['o','z']
+			return 31
 		}
 		return NoState
 	},
-	// S57
+	// S173
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S58
+	// S174
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 109: // ['a','m']
-			return 20
-		case r == 110: // ['n','n']
-			return 67
-		case 111 <= r && r <= 122: // ['o','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 102: // ['a','f']
+			return 31
+		case r == 103: // ['g','g']
+			return 187
+		case 104 <= r && r <= 122: // ['h','z']
+			return 31
 		}
 		return NoState
 	},
-	// S59
+	// S175
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 109: // ['a','m']
-			return 20
-		case r == 110: // ['n','n']
-			return 68
-		case 111 <= r && r <= 122: // ['o','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S60
+	// S176
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 102: // ['a','f']
-			return 20
-		case r == 103: // ['g','g']
-			return 69
-		case 104 <= r && r <= 122: // ['h','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S61
+	// S177
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S62
+	// S178
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 99: // ['a','c']
-			return 20
-		case r == 100: // ['d','d']
-			return 70
-		case 101 <= r && r <= 122: // ['e','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S63
+	// S179
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 107: // ['a','k']
-			return 20
-		case r == 108: // ['l','l']
-			return 71
-		case 109 <= r && r <= 122: // ['m','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S64
+	// S180
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S65
+	// S181
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 120: // ['a','x']
-			return 20
-		case r == 121: // ['y','y']
 			return 72
-		case r == 122: // ['z','z']
-			return 20
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 188
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
 		}
 		return NoState
 	},
-	// S66
+	// S182
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 115: // ['a','s']
-			return 20
+			return 31
 		case r == 116: // ['t','t']
-			return 73
+			return 189
 		case 117 <= r && r <= 122: // ['u','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S67
+	// S183
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S68
+	// S184
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 115: // ['a','s']
-			return 20
-		case r == 116: // ['t','t']
-			return 74
-		case 117 <= r && r <= 122: // ['u','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 108: // ['a','l']
+			return 31
+		case r == 109: // ['m','m']
+			return 190
+		case 110 <= r && r <= 122: // ['n','z']
+			return 31
 		}
 		return NoState
 	},
-	// S69
+	// S185
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 113: // ['a','q']
-			return 20
-		case r == 114: // ['r','r']
-			return 75
-		case 115 <= r && r <= 122: // ['s','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 100: // ['a','d']
+			return 31
+		case r == 101: // ['e','e']
+			return 191
+		case 102 <= r && r <= 122: // ['f','z']
+			return 31
 		}
 		return NoState
 	},
-	// S70
+	// S186
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S71
+	// S187
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 100: // ['a','d']
-			return 20
-		case r == 101: // ['e','e']
-			return 76
-		case 102 <= r && r <= 122: // ['f','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S72
+	// S188
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S73
+	// S189
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 110: // ['a','n']
+			return 31
+		case r == 111: // ['o','o']
+			return 192
+		case 112 <= r && r <= 122: // ['p','z']
+			return 31
 		}
 		return NoState
 	},
-	// S74
+	// S190
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
-	// S75
+	// S191
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case r == 97: // ['a','a']
-			return 77
-		case 98 <= r && r <= 122: // ['b','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 114: // ['a','r']
+			return 31
+		case r == 115: // ['s','s']
+			return 193
+		case 116 <= r && r <= 122: // ['t','z']
+			return 31
 		}
 		return NoState
 	},
-	// S76
+	// S192
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 113: // ['a','q']
+			return 31
+		case r == 114: // ['r','r']
+			return 194
+		case 115 <= r && r <= 122: // ['s','z']
+			return 31
 		}
 		return NoState
 	},
-	// S77
+	// S193
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
-		case 97 <= r && r <= 108: // ['a','l']
-			return 20
-		case r == 109: // ['m','m']
-			return 78
-		case 110 <= r && r <= 122: // ['n','z']
-			return 20
+			return 72
+		case 97 <= r && r <= 122: // ['a','z']
+			return 31
 		}
 		return NoState
 	},
-	// S78
+	// S194
 	func(r rune) int {
 		switch {
 		case 48 <= r && r <= 57: // ['0','9']
-			return 38
+			return 71
 		case 65 <= r && r <= 90: // ['A','Z']
-			return 17
+			return 21
 		case r == 95: // ['_','_']
-			return 39
+			return 72
 		case 97 <= r && r <= 122: // ['a','z']
-			return 20
+			return 31
 		}
 		return NoState
 	},
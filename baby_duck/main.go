@@ -0,0 +1,107 @@
+package main
+
+import (
+	"baby_duck/dialect"
+	"baby_duck/parser"
+	"baby_duck/semantics"
+	"flag"
+	"fmt"
+	"os"
+)
+
+// main: Compila y ejecuta un programa BabyDuck, o sus bloques test
+// Uso: baby_duck [-checked-overflow] [-gc-stats] [-no-contracts] [-lang es] programa.duck
+//
+//	baby_duck [-lang es] test programa.duck
+func main() {
+	checkedOverflow := flag.Bool("checked-overflow", false, "detiene el programa si una operación int se desborda")
+	gcStats := flag.Bool("gc-stats", false, "al terminar imprime las estadísticas del heap en stderr")
+	noContracts := flag.Bool("no-contracts", false, "compila sin revisar requires/ensures (versión final)")
+	lang := flag.String("lang", "", "dialecto de las palabras clave (es); también se puede poner #lang es al inicio del archivo")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "uso: baby_duck [opciones] programa.duck")
+		fmt.Fprintln(os.Stderr, "     baby_duck [opciones] test programa.duck")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	semantics.StripContracts = *noContracts
+
+	// baby_duck test programa.duck: ejecuta los bloques test
+	if flag.NArg() == 2 && flag.Arg(0) == "test" {
+		failed, err := runTests(flag.Arg(1), *lang)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), *lang, *checkedOverflow, *gcStats); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// compile: Lee y compila el archivo (deja los cuádruplos y directorios en semantics)
+func compile(path, lang string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// El dialecto viene del encabezado #lang o de la opción -lang
+	keywords, src, err := dialect.Detect(src)
+	if err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	if lang != "" {
+		var exists bool
+		if keywords, exists = dialect.Dialects[lang]; !exists {
+			return fmt.Errorf("dialecto desconocido '%s'", lang)
+		}
+	}
+	scanner := dialect.NewScannerFor(src, keywords)
+
+	semantics.ResetSemanticState()
+	semantics.SetSource(src)
+	if _, err := parser.NewParser().Parse(scanner); err != nil {
+		return fmt.Errorf("%s:%v", path, semantics.SourceError(err))
+	}
+	return nil
+}
+
+// run: Compila y ejecuta el archivo
+func run(path, lang string, checkedOverflow, gcStats bool) error {
+	if err := compile(path, lang); err != nil {
+		return err
+	}
+
+	// Ejecuta
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.CheckOverflow = checkedOverflow
+	err := vm.Run()
+
+	if gcStats {
+		stats := vm.HeapStats()
+		fmt.Fprintf(os.Stderr, "heap: %d objetos vivos, %d creados, %d liberados, %d recolecciones\n",
+			stats.LiveObjects, stats.Allocated, stats.Freed, stats.Collections)
+	}
+	return err
+}
+
+// runTests: Compila el archivo y ejecuta cada bloque test, regresa cuántos fallaron
+func runTests(path, lang string) (int, error) {
+	if err := compile(path, lang); err != nil {
+		return 0, err
+	}
+	_, failed := semantics.RunTests(os.Stdout)
+	return failed, nil
+}
@@ -0,0 +1,11 @@
+package parser
+
+import "baby_duck/semantics"
+
+// init: Las {expresiones} dentro de un string se compilan con un parser nuevo
+func init() {
+	semantics.ParseExpression = func(scanner semantics.Scanner) error {
+		_, err := NewParser().Parse(scanner)
+		return err
+	}
+}
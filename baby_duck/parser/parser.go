@@ -11,9 +11,9 @@ import (
 )
 
 const (
-	numProductions = 77
-	numStates      = 277
-	numSymbols     = 84
+	numProductions = 187
+	numStates      = 1426
+	numSymbols     = 175
 )
 
 // Stack
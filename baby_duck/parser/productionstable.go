@@ -45,14 +45,46 @@ var productionsTable = ProdTab{
 		},
 	},
 	ProdTabEntry{
-		String: `PBody : PHeader Vars FunctionList main	<< func() (Attrib, error) {
+		String: `Program : InterpStart Expression	<<  >>`,
+		Id:         "Program",
+		NTType:     1,
+		Index:      2,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `InterpStart : interp_start	<< func() (Attrib, error) {
+        err := semantics.HandleInterpStart(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "InterpStart",
+		NTType:     2,
+		Index:      3,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleInterpStart(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `PBody : PHeader Enums Vars FunctionList Init main	<< func() (Attrib, error) {
         semantics.HandlePBody(X[0])
         return nil, nil
       }() >>`,
 		Id:         "PBody",
-		NTType:     2,
-		Index:      2,
-		NumSymbols: 4,
+		NTType:     3,
+		Index:      4,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
         semantics.HandlePBody(X[0])
@@ -63,8 +95,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `PHeader : program id semicolon	<< semantics.HandlePHeader(X[1]) >>`,
 		Id:         "PHeader",
-		NTType:     3,
-		Index:      3,
+		NTType:     4,
+		Index:      5,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return semantics.HandlePHeader(X[1])
@@ -78,14 +110,68 @@ var productionsTable = ProdTab{
         return nil, nil
       }() >>`,
 		Id:         "PTail",
-		NTType:     4,
-		Index:      4,
+		NTType:     5,
+		Index:      6,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
         // 2) Generar ENDFUNC
         semantics.PushQuad(semantics.END, "_", "_", "_")
 
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Init : InitHeader Body	<< func() (Attrib, error) {
+        err := semantics.HandleInitBlock()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Init",
+		NTType:     6,
+		Index:      7,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleInitBlock()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Init : "empty"	<<  >>`,
+		Id:         "Init",
+		NTType:     6,
+		Index:      8,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `InitHeader : init	<< func() (Attrib, error) {
+        err := semantics.HandleInitHeader(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "InitHeader",
+		NTType:     7,
+		Index:      9,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleInitHeader(X[0])
+        if err != nil {
+          return nil, err
+        }
         return nil, nil
       }()
 		},
@@ -93,8 +179,18 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FunctionList : Function FunctionList	<<  >>`,
 		Id:         "FunctionList",
-		NTType:     5,
-		Index:      5,
+		NTType:     8,
+		Index:      10,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FunctionList : TestBlock FunctionList	<<  >>`,
+		Id:         "FunctionList",
+		NTType:     8,
+		Index:      11,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -103,18 +199,104 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `FunctionList : "empty"	<<  >>`,
 		Id:         "FunctionList",
-		NTType:     5,
-		Index:      6,
+		NTType:     8,
+		Index:      12,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `TestHeader : id cte_string	<< func() (Attrib, error) {
+        err := semantics.HandleTestHeader(X[0], X[1])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "TestHeader",
+		NTType:     9,
+		Index:      13,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTestHeader(X[0], X[1])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `TestBlock : TestHeader Body semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleTestBlock()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "TestBlock",
+		NTType:     10,
+		Index:      14,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTestBlock()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Enums : EnumDecl Enums	<<  >>`,
+		Id:         "Enums",
+		NTType:     11,
+		Index:      15,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Enums : "empty"	<<  >>`,
+		Id:         "Enums",
+		NTType:     11,
+		Index:      16,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
 		},
 	},
+	ProdTabEntry{
+		String: `EnumDecl : enum id l_curly_par IdList r_curly_par semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleEnumDecl(X[1], X[3])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "EnumDecl",
+		NTType:     12,
+		Index:      17,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleEnumDecl(X[1], X[3])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
 	ProdTabEntry{
 		String: `Vars : VarDecl Vars	<<  >>`,
 		Id:         "Vars",
-		NTType:     6,
-		Index:      7,
+		NTType:     13,
+		Index:      18,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -123,8 +305,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Vars : "empty"	<<  >>`,
 		Id:         "Vars",
-		NTType:     6,
-		Index:      8,
+		NTType:     13,
+		Index:      19,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return nil, nil
@@ -132,16 +314,22 @@ var productionsTable = ProdTab{
 	},
 	ProdTabEntry{
 		String: `VarDecl : var IdList colon Type semicolon	<< func() (Attrib, error) {
-        semantics.HandleVarDecl(X[1], X[3]) 
+        err := semantics.HandleVarDecl(X[1], X[3])
+        if err != nil {
+          return nil, err
+        }
         return nil, nil
       }() >>`,
 		Id:         "VarDecl",
-		NTType:     7,
-		Index:      9,
+		NTType:     14,
+		Index:      20,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandleVarDecl(X[1], X[3]) 
+        err := semantics.HandleVarDecl(X[1], X[3])
+        if err != nil {
+          return nil, err
+        }
         return nil, nil
       }()
 		},
@@ -165,8 +353,8 @@ var productionsTable = ProdTab{
             }
         }() >>`,
 		Id:         "IdList",
-		NTType:     8,
-		Index:      10,
+		NTType:     15,
+		Index:      21,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) { 
@@ -204,8 +392,8 @@ var productionsTable = ProdTab{
             }
         }() >>`,
 		Id:         "IdListTail",
-		NTType:     9,
-		Index:      11,
+		NTType:     16,
+		Index:      22,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
@@ -227,8 +415,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `IdListTail : "empty"	<< []string{}, nil >>`,
 		Id:         "IdListTail",
-		NTType:     9,
-		Index:      12,
+		NTType:     16,
+		Index:      23,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return []string{}, nil
@@ -237,8 +425,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Type : int	<< X[0].(*token.Token), nil >>`,
 		Id:         "Type",
-		NTType:     10,
-		Index:      13,
+		NTType:     17,
+		Index:      24,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0].(*token.Token), nil
@@ -247,581 +435,1911 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Type : float	<< X[0].(*token.Token), nil >>`,
 		Id:         "Type",
-		NTType:     10,
-		Index:      14,
+		NTType:     17,
+		Index:      25,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0].(*token.Token), nil
 		},
 	},
 	ProdTabEntry{
-		String: `FunctionHeader : void id l_round_par Params r_round_par l_square_par	<< semantics.HandleFunctionHeader(X[1], X[3]) >>`,
-		Id:         "FunctionHeader",
-		NTType:     11,
-		Index:      15,
-		NumSymbols: 6,
+		String: `Type : bigint	<< X[0].(*token.Token), nil >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      26,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return semantics.HandleFunctionHeader(X[1], X[3])
+			return X[0].(*token.Token), nil
 		},
 	},
 	ProdTabEntry{
-		String: `FunctionHeaderTwo : FunctionHeader Vars	<< semantics.HandleFunctionHeaderTwo(X[0]) >>`,
-		Id:         "FunctionHeaderTwo",
-		NTType:     12,
-		Index:      16,
-		NumSymbols: 2,
+		String: `Type : string	<< X[0].(*token.Token), nil >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      27,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return semantics.HandleFunctionHeaderTwo(X[0])
+			return X[0].(*token.Token), nil
 		},
 	},
 	ProdTabEntry{
-		String: `Function : FunctionHeaderTwo Body r_square_par semicolon	<< func() (Attrib, error) {
-        semantics.HandleFunction(X[0])
-        return nil, nil
+		String: `Type : id	<< X[0].(*token.Token), nil >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      28,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0].(*token.Token), nil
+		},
+	},
+	ProdTabEntry{
+		String: `Type : list of int	<< func() (Attrib, error) {
+        // El tipo de la lista es "list of <elemento>"
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte("list of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
       }() >>`,
-		Id:         "Function",
-		NTType:     13,
-		Index:      17,
-		NumSymbols: 4,
+		Id:         "Type",
+		NTType:     17,
+		Index:      29,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandleFunction(X[0])
-        return nil, nil
+        // El tipo de la lista es "list of <elemento>"
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte("list of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `Params : ParamList	<< func() (Attrib, error) {
-          // X[0] es el slice construido en ParamList
-          list, ok := X[0].([]semantics.VariableStructure)
-          if !ok {
-            return nil, fmt.Errorf("esperaba []VariableStructure en ParamList, pero fue %T", X[0])
-          }
-          return list, nil
-        }() >>`,
-		Id:         "Params",
-		NTType:     14,
-		Index:      18,
-		NumSymbols: 1,
+		String: `Type : list of float	<< func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte("list of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }() >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      30,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          // X[0] es el slice construido en ParamList
-          list, ok := X[0].([]semantics.VariableStructure)
-          if !ok {
-            return nil, fmt.Errorf("esperaba []VariableStructure en ParamList, pero fue %T", X[0])
-          }
-          return list, nil
-        }()
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte("list of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }()
 		},
 	},
 	ProdTabEntry{
-		String: `Params : "empty"	<< []semantics.VariableStructure{}, nil >>`,
-		Id:         "Params",
-		NTType:     14,
-		Index:      19,
-		NumSymbols: 0,
+		String: `Type : stack of int	<< func() (Attrib, error) {
+        // Pilas y colas se nombran igual que las listas ("stack of int")
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }() >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      31,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return []semantics.VariableStructure{}, nil
+			return func() (Attrib, error) {
+        // Pilas y colas se nombran igual que las listas ("stack of int")
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }()
 		},
 	},
 	ProdTabEntry{
-		String: `ParamList : id colon Type ParamListTail	<< func() (Attrib, error) {
-        // Crea el parametro con nombre y tipo
-        param, err := semantics.HandleParam(X[0], X[2])
-        if err != nil {
-            return nil, err
-        }
-
-        // Obtiene los otros parametros de tail
-        tail, _ := X[3].([]semantics.VariableStructure)
-
-        // Regresa lista actual + tail
-        return append([]semantics.VariableStructure{param}, tail...), nil
+		String: `Type : stack of float	<< func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
       }() >>`,
-		Id:         "ParamList",
-		NTType:     15,
-		Index:      20,
-		NumSymbols: 4,
+		Id:         "Type",
+		NTType:     17,
+		Index:      32,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        // Crea el parametro con nombre y tipo
-        param, err := semantics.HandleParam(X[0], X[2])
-        if err != nil {
-            return nil, err
-        }
-
-        // Obtiene los otros parametros de tail
-        tail, _ := X[3].([]semantics.VariableStructure)
-
-        // Regresa lista actual + tail
-        return append([]semantics.VariableStructure{param}, tail...), nil
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Type : queue of int	<< func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }() >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      33,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Type : queue of float	<< func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
+      }() >>`,
+		Id:         "Type",
+		NTType:     17,
+		Index:      34,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(string(tipo.Lit) + " of " + string(X[2].(*token.Token).Lit))
+        return &tipo, nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `ParamListTail : comma id colon Type ParamListTail	<< func() (Attrib, error) {
-        // Crea parametro con nombre y tipo
-        param, err := semantics.HandleParam(X[1], X[3])
+		String: `Type : map l_square_par Type r_square_par Type	<< func() (Attrib, error) {
+        // El tipo del mapa es "map[<clave>]<valor>"
+        name, err := semantics.MapTypeName(string(X[2].(*token.Token).Lit), string(X[4].(*token.Token).Lit))
         if err != nil {
-            return nil, err
+          return nil, err
         }
-
-        // Obtiene tail
-        tail, _ := X[4].([]semantics.VariableStructure)
-
-        // Regresa parametro con resto del tail
-        return append([]semantics.VariableStructure{param}, tail...), nil
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(name)
+        return &tipo, nil
       }() >>`,
-		Id:         "ParamListTail",
-		NTType:     16,
-		Index:      21,
+		Id:         "Type",
+		NTType:     17,
+		Index:      35,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        // Crea parametro con nombre y tipo
-        param, err := semantics.HandleParam(X[1], X[3])
+        // El tipo del mapa es "map[<clave>]<valor>"
+        name, err := semantics.MapTypeName(string(X[2].(*token.Token).Lit), string(X[4].(*token.Token).Lit))
         if err != nil {
-            return nil, err
+          return nil, err
         }
-
-        // Obtiene tail
-        tail, _ := X[4].([]semantics.VariableStructure)
-
-        // Regresa parametro con resto del tail
-        return append([]semantics.VariableStructure{param}, tail...), nil
+        tipo := *X[0].(*token.Token)
+        tipo.Lit = []byte(name)
+        return &tipo, nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `ParamListTail : "empty"	<< []semantics.VariableStructure{}, nil >>`,
-		Id:         "ParamListTail",
-		NTType:     16,
-		Index:      22,
-		NumSymbols: 0,
+		String: `FunctionSignature : void id l_round_par Params r_round_par	<< semantics.HandleFunctionHeader(X[1], X[3]) >>`,
+		Id:         "FunctionSignature",
+		NTType:     18,
+		Index:      36,
+		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return []semantics.VariableStructure{}, nil
+			return semantics.HandleFunctionHeader(X[1], X[3])
 		},
 	},
 	ProdTabEntry{
-		String: `Body : l_curly_par StatementList r_curly_par	<<  >>`,
-		Id:         "Body",
-		NTType:     17,
-		Index:      23,
-		NumSymbols: 3,
+		String: `FunctionSignature : generator Type id l_round_par Params r_round_par	<< semantics.HandleGeneratorHeader(X[1], X[2], X[4]) >>`,
+		Id:         "FunctionSignature",
+		NTType:     18,
+		Index:      37,
+		NumSymbols: 6,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return semantics.HandleGeneratorHeader(X[1], X[2], X[4])
 		},
 	},
 	ProdTabEntry{
-		String: `StatementList : Statement StatementList	<<  >>`,
-		Id:         "StatementList",
+		String: `FunctionSignature : func id l_round_par Params r_round_par colon l_round_par TypeList r_round_par	<< semantics.HandleReturningHeader(X[1], X[3], X[7]) >>`,
+		Id:         "FunctionSignature",
 		NTType:     18,
-		Index:      24,
-		NumSymbols: 2,
+		Index:      38,
+		NumSymbols: 9,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return semantics.HandleReturningHeader(X[1], X[3], X[7])
 		},
 	},
 	ProdTabEntry{
-		String: `StatementList : "empty"	<<  >>`,
-		Id:         "StatementList",
+		String: `FunctionSignature : func id l_round_par Params r_round_par colon Type	<< semantics.HandleReturningHeader(X[1], X[3], []string{string(X[6].(*token.Token).Lit)}) >>`,
+		Id:         "FunctionSignature",
 		NTType:     18,
-		Index:      25,
-		NumSymbols: 0,
+		Index:      39,
+		NumSymbols: 7,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return semantics.HandleReturningHeader(X[1], X[3], []string{string(X[6].(*token.Token).Lit)})
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : Assign	<<  >>`,
-		Id:         "Statement",
+		String: `TypeList : Type	<< []string{string(X[0].(*token.Token).Lit)}, nil >>`,
+		Id:         "TypeList",
 		NTType:     19,
-		Index:      26,
+		Index:      40,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return []string{string(X[0].(*token.Token).Lit)}, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : Condition	<<  >>`,
-		Id:         "Statement",
+		String: `TypeList : Type comma TypeList	<< append([]string{string(X[0].(*token.Token).Lit)}, X[2].([]string)...), nil >>`,
+		Id:         "TypeList",
 		NTType:     19,
-		Index:      27,
-		NumSymbols: 1,
+		Index:      41,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return append([]string{string(X[0].(*token.Token).Lit)}, X[2].([]string)...), nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : Cycle	<<  >>`,
-		Id:         "Statement",
-		NTType:     19,
-		Index:      28,
-		NumSymbols: 1,
+		String: `FunctionHeader : FunctionSignature Contracts l_square_par	<< semantics.EndContracts(X[0]) >>`,
+		Id:         "FunctionHeader",
+		NTType:     20,
+		Index:      42,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return semantics.EndContracts(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : FCall	<<  >>`,
-		Id:         "Statement",
-		NTType:     19,
-		Index:      29,
-		NumSymbols: 1,
+		String: `Contracts : Contract Contracts	<<  >>`,
+		Id:         "Contracts",
+		NTType:     21,
+		Index:      43,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Statement : Print	<<  >>`,
-		Id:         "Statement",
-		NTType:     19,
-		Index:      30,
-		NumSymbols: 1,
+		String: `Contracts : "empty"	<<  >>`,
+		Id:         "Contracts",
+		NTType:     21,
+		Index:      44,
+		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return X[0], nil
+			return nil, nil
 		},
 	},
 	ProdTabEntry{
-		String: `Assign : id assign Expression semicolon	<< func() (Attrib, error) {
-        tokenID := X[0].(*token.Token)
-        name := string(tokenID.Lit)
-        
-        // Verifica si la variable está declarada
-        if _, exists := semantics.Scopes.Current().Get(name); !exists {
-            return nil, fmt.Errorf("error: variable '%s' no declarada", name)
+		String: `Contract : requires Expression semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleContract(X[0], X[2])
+        if err != nil {
+          return nil, err
         }
-
-        // Si pasa verifivación se hace la asignación
-        semantics.HandleAssign(X[0]) 
-
         return nil, nil
       }() >>`,
-		Id:         "Assign",
-		NTType:     20,
-		Index:      31,
-		NumSymbols: 4,
+		Id:         "Contract",
+		NTType:     22,
+		Index:      45,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        tokenID := X[0].(*token.Token)
-        name := string(tokenID.Lit)
-        
-        // Verifica si la variable está declarada
-        if _, exists := semantics.Scopes.Current().Get(name); !exists {
-            return nil, fmt.Errorf("error: variable '%s' no declarada", name)
+        err := semantics.HandleContract(X[0], X[2])
+        if err != nil {
+          return nil, err
         }
-
-        // Si pasa verifivación se hace la asignación
-        semantics.HandleAssign(X[0]) 
-
         return nil, nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `Condition : if ConditionTail Body Else semicolon	<< func() (Attrib, error) {
-      semantics.HandleCondition(X[3].(bool))
-      return nil, nil
-    }() >>`,
-		Id:         "Condition",
-		NTType:     21,
-		Index:      32,
-		NumSymbols: 5,
+		String: `Contract : ensures Expression semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleContract(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Contract",
+		NTType:     22,
+		Index:      46,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-      semantics.HandleCondition(X[3].(bool))
-      return nil, nil
-    }()
+        err := semantics.HandleContract(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
 		},
 	},
 	ProdTabEntry{
-		String: `ConditionTail : l_round_par Expression r_round_par	<< func() (Attrib, error) {
-          semantics.HandleConditionTail() 
-          return nil, nil
-        }() >>`,
-		Id:         "ConditionTail",
-		NTType:     22,
-		Index:      33,
-		NumSymbols: 3,
+		String: `FunctionHeaderTwo : FunctionHeader Vars	<< semantics.HandleFunctionHeaderTwo(X[0]) >>`,
+		Id:         "FunctionHeaderTwo",
+		NTType:     23,
+		Index:      47,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return func() (Attrib, error) {
-          semantics.HandleConditionTail() 
-          return nil, nil
-        }()
+			return semantics.HandleFunctionHeaderTwo(X[0])
 		},
 	},
 	ProdTabEntry{
-		String: `Else : else ElseTail Body	<< func() (Attrib, error) {
-        return true, nil // para saber que hay else
+		String: `Function : FunctionHeaderTwo Body r_square_par semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleFunction(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
       }() >>`,
-		Id:         "Else",
-		NTType:     23,
-		Index:      34,
-		NumSymbols: 3,
+		Id:         "Function",
+		NTType:     24,
+		Index:      48,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleFunction(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Params : ParamList	<< func() (Attrib, error) {
+          // X[0] es el slice construido en ParamList
+          list, ok := X[0].([]semantics.VariableStructure)
+          if !ok {
+            return nil, fmt.Errorf("esperaba []VariableStructure en ParamList, pero fue %T", X[0])
+          }
+          return list, nil
+        }() >>`,
+		Id:         "Params",
+		NTType:     25,
+		Index:      49,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          // X[0] es el slice construido en ParamList
+          list, ok := X[0].([]semantics.VariableStructure)
+          if !ok {
+            return nil, fmt.Errorf("esperaba []VariableStructure en ParamList, pero fue %T", X[0])
+          }
+          return list, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Params : "empty"	<< []semantics.VariableStructure{}, nil >>`,
+		Id:         "Params",
+		NTType:     25,
+		Index:      50,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []semantics.VariableStructure{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ParamList : id colon Type ParamDefault ParamListTail	<< func() (Attrib, error) {
+        // Crea el parametro con nombre, tipo y valor por defecto
+        param, err := semantics.HandleParam(X[0], X[2], X[3])
+        if err != nil {
+            return nil, err
+        }
+
+        // Obtiene los otros parametros de tail
+        tail, _ := X[4].([]semantics.VariableStructure)
+
+        // Regresa lista actual + tail
+        return append([]semantics.VariableStructure{param}, tail...), nil
+      }() >>`,
+		Id:         "ParamList",
+		NTType:     26,
+		Index:      51,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Crea el parametro con nombre, tipo y valor por defecto
+        param, err := semantics.HandleParam(X[0], X[2], X[3])
+        if err != nil {
+            return nil, err
+        }
+
+        // Obtiene los otros parametros de tail
+        tail, _ := X[4].([]semantics.VariableStructure)
+
+        // Regresa lista actual + tail
+        return append([]semantics.VariableStructure{param}, tail...), nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `ParamListTail : comma id colon Type ParamDefault ParamListTail	<< func() (Attrib, error) {
+        // Crea parametro con nombre, tipo y valor por defecto
+        param, err := semantics.HandleParam(X[1], X[3], X[4])
+        if err != nil {
+            return nil, err
+        }
+
+        // Obtiene tail
+        tail, _ := X[5].([]semantics.VariableStructure)
+
+        // Regresa parametro con resto del tail
+        return append([]semantics.VariableStructure{param}, tail...), nil
+      }() >>`,
+		Id:         "ParamListTail",
+		NTType:     27,
+		Index:      52,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Crea parametro con nombre, tipo y valor por defecto
+        param, err := semantics.HandleParam(X[1], X[3], X[4])
+        if err != nil {
+            return nil, err
+        }
+
+        // Obtiene tail
+        tail, _ := X[5].([]semantics.VariableStructure)
+
+        // Regresa parametro con resto del tail
+        return append([]semantics.VariableStructure{param}, tail...), nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `ParamListTail : "empty"	<< []semantics.VariableStructure{}, nil >>`,
+		Id:         "ParamListTail",
+		NTType:     27,
+		Index:      53,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return []semantics.VariableStructure{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `ParamDefault : assign Cte	<< X[1], nil >>`,
+		Id:         "ParamDefault",
+		NTType:     28,
+		Index:      54,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ParamDefault : assign rest Cte	<< func() (Attrib, error) {
+        cte := *X[2].(*token.Token)
+        cte.Lit = append([]byte("-"), cte.Lit...)
+        return &cte, nil
+      }() >>`,
+		Id:         "ParamDefault",
+		NTType:     28,
+		Index:      55,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        cte := *X[2].(*token.Token)
+        cte.Lit = append([]byte("-"), cte.Lit...)
+        return &cte, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `ParamDefault : ellipsis	<< X[0], nil >>`,
+		Id:         "ParamDefault",
+		NTType:     28,
+		Index:      56,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ParamDefault : "empty"	<< nil, nil >>`,
+		Id:         "ParamDefault",
+		NTType:     28,
+		Index:      57,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Body : l_curly_par StatementList r_curly_par	<<  >>`,
+		Id:         "Body",
+		NTType:     29,
+		Index:      58,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `StatementList : Statement StatementList	<<  >>`,
+		Id:         "StatementList",
+		NTType:     30,
+		Index:      59,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `StatementList : "empty"	<<  >>`,
+		Id:         "StatementList",
+		NTType:     30,
+		Index:      60,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Assign	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      61,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : InferDecl	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      62,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : CompoundAssign	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      63,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Condition	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      64,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Cycle	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      65,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : FCall	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      66,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Print	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      67,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Assert	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      68,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Try	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      69,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Throw	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      70,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Yield	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      71,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : Return	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      72,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Statement : MultiAssign	<<  >>`,
+		Id:         "Statement",
+		NTType:     31,
+		Index:      73,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Return : return semicolon	<< nil, semantics.HandleReturn(X[0], 0) >>`,
+		Id:         "Return",
+		NTType:     32,
+		Index:      74,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, semantics.HandleReturn(X[0], 0)
+		},
+	},
+	ProdTabEntry{
+		String: `Return : return CallArgs semicolon	<< nil, semantics.HandleReturn(X[0], X[1].(int)) >>`,
+		Id:         "Return",
+		NTType:     32,
+		Index:      75,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, semantics.HandleReturn(X[0], X[1].(int))
+		},
+	},
+	ProdTabEntry{
+		String: `MultiAssign : id comma IdList assign FEra l_round_par FCallList r_round_par semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleMultiAssign(X[0], X[2], X[4], X[6].([]string))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "MultiAssign",
+		NTType:     33,
+		Index:      76,
+		NumSymbols: 9,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleMultiAssign(X[0], X[2], X[4], X[6].([]string))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : id assign Expression semicolon	<< func() (Attrib, error) {
+        tokenID := X[0].(*token.Token)
+        name := string(tokenID.Lit)
+        
+        // Verifica si la variable está declarada
+        if _, exists := semantics.Scopes.Current().Get(name); !exists {
+            return nil, fmt.Errorf("error: variable '%s' no declarada", name)
+        }
+
+        // Si pasa verifivación se hace la asignación
+        if err := semantics.HandleAssign(X[0]); err != nil {
+            return nil, err
+        }
+
+        return nil, nil
+      }() >>`,
+		Id:         "Assign",
+		NTType:     34,
+		Index:      77,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        tokenID := X[0].(*token.Token)
+        name := string(tokenID.Lit)
+        
+        // Verifica si la variable está declarada
+        if _, exists := semantics.Scopes.Current().Get(name); !exists {
+            return nil, fmt.Errorf("error: variable '%s' no declarada", name)
+        }
+
+        // Si pasa verifivación se hace la asignación
+        if err := semantics.HandleAssign(X[0]); err != nil {
+            return nil, err
+        }
+
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Assign : id IndexOpen Expression IndexClose assign Expression semicolon	<< func() (Attrib, error) {
+        // Asignación a un elemento de lista (l[i] = x) o de mapa (m[k] = x)
+        err := semantics.HandleIndexAssign(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Assign",
+		NTType:     34,
+		Index:      78,
+		NumSymbols: 7,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Asignación a un elemento de lista (l[i] = x) o de mapa (m[k] = x)
+        err := semantics.HandleIndexAssign(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `InferDecl : var id infer_assign Expression semicolon	<< func() (Attrib, error) {
+        // Declara la variable con el tipo de la expresión y la inicializa
+        err := semantics.HandleInferDecl(X[1])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "InferDecl",
+		NTType:     35,
+		Index:      79,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Declara la variable con el tipo de la expresión y la inicializa
+        err := semantics.HandleInferDecl(X[1])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundAssign : id CompoundOperator Expression semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleCompoundAssign(X[0], X[1].(int))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CompoundAssign",
+		NTType:     36,
+		Index:      80,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleCompoundAssign(X[0], X[1].(int))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundAssign : CompoundIndex Expression semicolon	<< func() (Attrib, error) {
+        // l[i] op= x o m[k] op= x: escribe con el índice que leyó CompoundIndex
+        err := semantics.HandleCompoundIndexAssign(X[0].(semantics.IndexTarget))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CompoundAssign",
+		NTType:     36,
+		Index:      81,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // l[i] op= x o m[k] op= x: escribe con el índice que leyó CompoundIndex
+        err := semantics.HandleCompoundIndexAssign(X[0].(semantics.IndexTarget))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundAssign : id increment semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleIncrement(X[0], semantics.ADD)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CompoundAssign",
+		NTType:     36,
+		Index:      82,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleIncrement(X[0], semantics.ADD)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundAssign : id decrement semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleIncrement(X[0], semantics.REST)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CompoundAssign",
+		NTType:     36,
+		Index:      83,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleIncrement(X[0], semantics.REST)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundIndex : id IndexOpen Expression IndexClose CompoundOperator	<< func() (Attrib, error) {
+        target, err := semantics.HandleCompoundIndex(X[0], X[4].(int))
+        if err != nil {
+          return nil, err
+        }
+        return target, nil
+      }() >>`,
+		Id:         "CompoundIndex",
+		NTType:     37,
+		Index:      84,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        target, err := semantics.HandleCompoundIndex(X[0], X[4].(int))
+        if err != nil {
+          return nil, err
+        }
+        return target, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundOperator : add_assign	<< semantics.ADD, nil >>`,
+		Id:         "CompoundOperator",
+		NTType:     38,
+		Index:      85,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return semantics.ADD, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundOperator : rest_assign	<< semantics.REST, nil >>`,
+		Id:         "CompoundOperator",
+		NTType:     38,
+		Index:      86,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return semantics.REST, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundOperator : mul_assign	<< semantics.MULTIPLY, nil >>`,
+		Id:         "CompoundOperator",
+		NTType:     38,
+		Index:      87,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return semantics.MULTIPLY, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CompoundOperator : div_assign	<< semantics.DIVIDE, nil >>`,
+		Id:         "CompoundOperator",
+		NTType:     38,
+		Index:      88,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return semantics.DIVIDE, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Condition : if ConditionTail Body Else semicolon	<< func() (Attrib, error) {
+      semantics.HandleCondition(X[3].(bool))
+      return nil, nil
+    }() >>`,
+		Id:         "Condition",
+		NTType:     39,
+		Index:      89,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      semantics.HandleCondition(X[3].(bool))
+      return nil, nil
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `ConditionTail : l_round_par Expression r_round_par	<< func() (Attrib, error) {
+          semantics.HandleConditionTail() 
+          return nil, nil
+        }() >>`,
+		Id:         "ConditionTail",
+		NTType:     40,
+		Index:      90,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.HandleConditionTail() 
+          return nil, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Else : else ElseTail Body	<< func() (Attrib, error) {
+        return true, nil // para saber que hay else
+      }() >>`,
+		Id:         "Else",
+		NTType:     41,
+		Index:      91,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        return true, nil // para saber que hay else
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Else : "empty"	<< func() (Attrib, error) {
+        return false, nil // para saber que NO hay else
+      }() >>`,
+		Id:         "Else",
+		NTType:     41,
+		Index:      92,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        return false, nil // para saber que NO hay else
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `ElseTail : empty	<< func() (Attrib, error) {
+        semantics.HandleElseTail()
+        return nil, nil
+      }() >>`,
+		Id:         "ElseTail",
+		NTType:     42,
+		Index:      93,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        semantics.HandleElseTail()
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CycleHeader : while	<< func() (Attrib, error) {
+      semantics.HandleCycleHeader()
+      return nil, nil
+    }() >>`,
+		Id:         "CycleHeader",
+		NTType:     43,
+		Index:      94,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      semantics.HandleCycleHeader()
+      return nil, nil
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `Cycle : CycleHeader CycleExpression do Body CycleTail semicolon	<<  >>`,
+		Id:         "Cycle",
+		NTType:     44,
+		Index:      95,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `Cycle : ForHeader do Body ForTail semicolon	<<  >>`,
+		Id:         "Cycle",
+		NTType:     44,
+		Index:      96,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `ForHeader : for id in Expression	<< func() (Attrib, error) {
+      err := semantics.HandleForHeader(X[1])
+      if err != nil {
+        return nil, err
+      }
+      return nil, nil
+    }() >>`,
+		Id:         "ForHeader",
+		NTType:     45,
+		Index:      97,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      err := semantics.HandleForHeader(X[1])
+      if err != nil {
+        return nil, err
+      }
+      return nil, nil
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `ForTail : empty	<< func() (Attrib, error) {
+      err := semantics.HandleForTail()
+      return nil, err
+    }() >>`,
+		Id:         "ForTail",
+		NTType:     46,
+		Index:      98,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      err := semantics.HandleForTail()
+      return nil, err
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `CycleExpression : l_round_par Expression r_round_par	<< func() (Attrib, error) {
+      semantics.HandleCycleExpression()
+      return nil, nil
+    }() >>`,
+		Id:         "CycleExpression",
+		NTType:     47,
+		Index:      99,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      semantics.HandleCycleExpression()
+      return nil, nil
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `CycleTail : empty	<< func() (Attrib, error) {
+      semantics.HandleCycleTail()
+      return nil, nil
+    }() >>`,
+		Id:         "CycleTail",
+		NTType:     48,
+		Index:      100,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+      semantics.HandleCycleTail()
+      return nil, nil
+    }()
+		},
+	},
+	ProdTabEntry{
+		String: `Print : print l_round_par PrintList r_round_par semicolon	<< func() (Attrib, error) {
+          semantics.FinalizePrint(true)
+          return nil, nil
+      }() >>`,
+		Id:         "Print",
+		NTType:     49,
+		Index:      101,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.FinalizePrint(true)
+          return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Print : write l_round_par PrintList r_round_par semicolon	<< func() (Attrib, error) {
+          semantics.FinalizePrint(false)
+          return nil, nil
+      }() >>`,
+		Id:         "Print",
+		NTType:     49,
+		Index:      102,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.FinalizePrint(false)
+          return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Print : printf l_round_par cte_string PrintfArgs r_round_par semicolon	<< func() (Attrib, error) {
+          err := semantics.HandlePrintf(X[2], X[3].(int))
+          if err != nil {
+            return nil, err
+          }
+          return nil, nil
+      }() >>`,
+		Id:         "Print",
+		NTType:     49,
+		Index:      103,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          err := semantics.HandlePrintf(X[2], X[3].(int))
+          if err != nil {
+            return nil, err
+          }
+          return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `PrintfArgs : comma Expression PrintfArgs	<< X[2].(int) + 1, nil >>`,
+		Id:         "PrintfArgs",
+		NTType:     50,
+		Index:      104,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[2].(int) + 1, nil
+		},
+	},
+	ProdTabEntry{
+		String: `PrintfArgs : "empty"	<< 0, nil >>`,
+		Id:         "PrintfArgs",
+		NTType:     50,
+		Index:      105,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return 0, nil
+		},
+	},
+	ProdTabEntry{
+		String: `PrintList : Expression PrintListTail	<< func() (Attrib, error) {
+        semantics.HandlePrintExpression()
+        return nil, nil
+      }() >>`,
+		Id:         "PrintList",
+		NTType:     51,
+		Index:      106,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        semantics.HandlePrintExpression()
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `PrintListTail : comma Expression PrintListTail	<< func() (Attrib, error) {
+        semantics.HandlePrintExpression()
+        return nil, nil
+      }() >>`,
+		Id:         "PrintListTail",
+		NTType:     52,
+		Index:      107,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        semantics.HandlePrintExpression()
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `PrintListTail : "empty"	<< nil, nil >>`,
+		Id:         "PrintListTail",
+		NTType:     52,
+		Index:      108,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Assert : assert l_round_par Expression AssertMessage r_round_par semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleAssert(X[0], X[3])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Assert",
+		NTType:     53,
+		Index:      109,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleAssert(X[0], X[3])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `AssertMessage : comma cte_string	<< X[1], nil >>`,
+		Id:         "AssertMessage",
+		NTType:     54,
+		Index:      110,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[1], nil
+		},
+	},
+	ProdTabEntry{
+		String: `AssertMessage : "empty"	<< nil, nil >>`,
+		Id:         "AssertMessage",
+		NTType:     54,
+		Index:      111,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return nil, nil
+		},
+	},
+	ProdTabEntry{
+		String: `Try : TryHeader Body CatchHeader Body semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleTry()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Try",
+		NTType:     55,
+		Index:      112,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTry()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `TryHeader : try	<< func() (Attrib, error) {
+        err := semantics.HandleTryHeader()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "TryHeader",
+		NTType:     56,
+		Index:      113,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTryHeader()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CatchHeader : catch l_round_par id r_round_par	<< func() (Attrib, error) {
+        err := semantics.HandleCatchHeader(X[2], nil)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CatchHeader",
+		NTType:     57,
+		Index:      114,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleCatchHeader(X[2], nil)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CatchHeader : catch l_round_par id comma id r_round_par	<< func() (Attrib, error) {
+        // catch (code, msg): también recibe el mensaje de la excepción
+        err := semantics.HandleCatchHeader(X[2], X[4])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "CatchHeader",
+		NTType:     57,
+		Index:      115,
+		NumSymbols: 6,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // catch (code, msg): también recibe el mensaje de la excepción
+        err := semantics.HandleCatchHeader(X[2], X[4])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Throw : throw Expression semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleThrow(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Throw",
+		NTType:     58,
+		Index:      116,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleThrow(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Yield : yield Expression semicolon	<< func() (Attrib, error) {
+        err := semantics.HandleYield(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Yield",
+		NTType:     59,
+		Index:      117,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleYield(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Expression : BitOrExpression	<< func() (Attrib, error) {
+        return X[0], nil
+      }() >>`,
+		Id:         "Expression",
+		NTType:     60,
+		Index:      118,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        return X[0], nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Expression : BitOrExpression TernaryIf Expression TernaryElse Expression	<< func() (Attrib, error) {
+        err := semantics.HandleTernary()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Expression",
+		NTType:     60,
+		Index:      119,
+		NumSymbols: 5,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTernary()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `TernaryIf : question	<< func() (Attrib, error) {
+        err := semantics.HandleTernaryIf()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "TernaryIf",
+		NTType:     61,
+		Index:      120,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTernaryIf()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `TernaryElse : colon	<< func() (Attrib, error) {
+        err := semantics.HandleTernaryElse()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "TernaryElse",
+		NTType:     62,
+		Index:      121,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.HandleTernaryElse()
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `BitOrExpression : BitXorExpression BitOrList	<<  >>`,
+		Id:         "BitOrExpression",
+		NTType:     63,
+		Index:      122,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `BitOrList : OperatorBitOr BitXorExpression BitOrList	<< func() (Attrib, error) {
+          err := semantics.DoBitOr()
+          return nil, err
+        }() >>`,
+		Id:         "BitOrList",
+		NTType:     64,
+		Index:      123,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          err := semantics.DoBitOr()
+          return nil, err
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `BitOrList : "empty"	<< func() (Attrib, error) {
+          err := semantics.DoBitOr()
+          return nil, err
+        }() >>`,
+		Id:         "BitOrList",
+		NTType:     64,
+		Index:      124,
+		NumSymbols: 0,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          err := semantics.DoBitOr()
+          return nil, err
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `OperatorBitOr : bit_or	<< func() (Attrib, error) {
+          if err := semantics.DoBitOr(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITOR, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "OperatorBitOr",
+		NTType:     65,
+		Index:      125,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          if err := semantics.DoBitOr(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITOR, X[0])
+          return nil, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `BitXorExpression : BitAndExpression BitXorList	<<  >>`,
+		Id:         "BitXorExpression",
+		NTType:     66,
+		Index:      126,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return func() (Attrib, error) {
-        return true, nil // para saber que hay else
-      }()
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `Else : "empty"	<< func() (Attrib, error) {
-        return false, nil // para saber que NO hay else
-      }() >>`,
-		Id:         "Else",
-		NTType:     23,
-		Index:      35,
-		NumSymbols: 0,
+		String: `BitXorList : OperatorBitXor BitAndExpression BitXorList	<< func() (Attrib, error) {
+          err := semantics.DoBitXor()
+          return nil, err
+        }() >>`,
+		Id:         "BitXorList",
+		NTType:     67,
+		Index:      127,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        return false, nil // para saber que NO hay else
-      }()
+          err := semantics.DoBitXor()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `ElseTail : empty	<< func() (Attrib, error) {
-        semantics.HandleElseTail()
-        return nil, nil
-      }() >>`,
-		Id:         "ElseTail",
-		NTType:     24,
-		Index:      36,
+		String: `BitXorList : "empty"	<< func() (Attrib, error) {
+          err := semantics.DoBitXor()
+          return nil, err
+        }() >>`,
+		Id:         "BitXorList",
+		NTType:     67,
+		Index:      128,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandleElseTail()
-        return nil, nil
-      }()
+          err := semantics.DoBitXor()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `CycleHeader : while	<< func() (Attrib, error) {
-      semantics.HandleCycleHeader()
-      return nil, nil
-    }() >>`,
-		Id:         "CycleHeader",
-		NTType:     25,
-		Index:      37,
+		String: `OperatorBitXor : xor	<< func() (Attrib, error) {
+          if err := semantics.DoBitXor(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITXOR, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "OperatorBitXor",
+		NTType:     68,
+		Index:      129,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-      semantics.HandleCycleHeader()
-      return nil, nil
-    }()
+          if err := semantics.DoBitXor(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITXOR, X[0])
+          return nil, nil
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `Cycle : CycleHeader CycleExpression do Body CycleTail semicolon	<<  >>`,
-		Id:         "Cycle",
-		NTType:     26,
-		Index:      38,
-		NumSymbols: 6,
+		String: `BitAndExpression : RelExpression BitAndList	<<  >>`,
+		Id:         "BitAndExpression",
+		NTType:     69,
+		Index:      130,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `CycleExpression : l_round_par Expression r_round_par	<< func() (Attrib, error) {
-      semantics.HandleCycleExpression()
-      return nil, nil
-    }() >>`,
-		Id:         "CycleExpression",
-		NTType:     27,
-		Index:      39,
+		String: `BitAndList : OperatorBitAnd RelExpression BitAndList	<< func() (Attrib, error) {
+          err := semantics.DoBitAnd()
+          return nil, err
+        }() >>`,
+		Id:         "BitAndList",
+		NTType:     70,
+		Index:      131,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-      semantics.HandleCycleExpression()
-      return nil, nil
-    }()
+          err := semantics.DoBitAnd()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `CycleTail : empty	<< func() (Attrib, error) {
-      semantics.HandleCycleTail()
-      return nil, nil
-    }() >>`,
-		Id:         "CycleTail",
-		NTType:     28,
-		Index:      40,
+		String: `BitAndList : "empty"	<< func() (Attrib, error) {
+          err := semantics.DoBitAnd()
+          return nil, err
+        }() >>`,
+		Id:         "BitAndList",
+		NTType:     70,
+		Index:      132,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-      semantics.HandleCycleTail()
-      return nil, nil
-    }()
+          err := semantics.DoBitAnd()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `Print : print l_round_par PrintList r_round_par semicolon	<< func() (Attrib, error) {
-          semantics.FinalizePrint()
+		String: `OperatorBitAnd : bit_and	<< func() (Attrib, error) {
+          if err := semantics.DoBitAnd(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITAND, X[0])
           return nil, nil
-      }() >>`,
-		Id:         "Print",
-		NTType:     29,
-		Index:      41,
-		NumSymbols: 5,
+        }() >>`,
+		Id:         "OperatorBitAnd",
+		NTType:     71,
+		Index:      133,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.FinalizePrint()
+          if err := semantics.DoBitAnd(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.BITAND, X[0])
           return nil, nil
-      }()
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `PrintList : Expression PrintListTail	<< func() (Attrib, error) {
-        semantics.HandlePrintExpression()
-        return nil, nil
-      }() >>`,
-		Id:         "PrintList",
-		NTType:     30,
-		Index:      42,
-		NumSymbols: 2,
+		String: `RelExpression : ShiftExpression Operator ShiftExpression	<< func() (Attrib, error) {
+          err := semantics.DoRelational()
+          if err != nil {
+            return nil, err
+          }
+          return nil, nil
+        }() >>`,
+		Id:         "RelExpression",
+		NTType:     72,
+		Index:      134,
+		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandlePrintExpression()
-        return nil, nil
-      }()
+          err := semantics.DoRelational()
+          if err != nil {
+            return nil, err
+          }
+          return nil, nil
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `PrintList : cte_string PrintListTail	<< func() (Attrib, error) {
-        semantics.HandlePrintString(X[0])
-        return nil, nil
+		String: `RelExpression : ShiftExpression	<< func() (Attrib, error) {
+        return X[0], nil
       }() >>`,
-		Id:         "PrintList",
-		NTType:     30,
-		Index:      43,
-		NumSymbols: 2,
+		Id:         "RelExpression",
+		NTType:     72,
+		Index:      135,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandlePrintString(X[0])
-        return nil, nil
+        return X[0], nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `PrintListTail : comma Expression PrintListTail	<< func() (Attrib, error) {
-        semantics.HandlePrintExpression()
-        return nil, nil
-      }() >>`,
-		Id:         "PrintListTail",
-		NTType:     31,
-		Index:      44,
-		NumSymbols: 3,
+		String: `ShiftExpression : Exp ShiftList	<<  >>`,
+		Id:         "ShiftExpression",
+		NTType:     73,
+		Index:      136,
+		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return func() (Attrib, error) {
-        semantics.HandlePrintExpression()
-        return nil, nil
-      }()
+			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `PrintListTail : comma cte_string PrintListTail	<< func() (Attrib, error) {
-        semantics.HandlePrintString(X[1])
-        return nil, nil
-      }() >>`,
-		Id:         "PrintListTail",
-		NTType:     31,
-		Index:      45,
+		String: `ShiftList : OperatorShift Exp ShiftList	<< func() (Attrib, error) {
+          err := semantics.DoShift()
+          return nil, err
+        }() >>`,
+		Id:         "ShiftList",
+		NTType:     74,
+		Index:      137,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        semantics.HandlePrintString(X[1])
-        return nil, nil
-      }()
+          err := semantics.DoShift()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `PrintListTail : "empty"	<< nil, nil >>`,
-		Id:         "PrintListTail",
-		NTType:     31,
-		Index:      46,
+		String: `ShiftList : "empty"	<< func() (Attrib, error) {
+          err := semantics.DoShift()
+          return nil, err
+        }() >>`,
+		Id:         "ShiftList",
+		NTType:     74,
+		Index:      138,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return nil, nil
+			return func() (Attrib, error) {
+          err := semantics.DoShift()
+          return nil, err
+        }()
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp Operator Exp	<< func() (Attrib, error) {
-          err := semantics.DoRelational()
-          if err != nil {
+		String: `OperatorShift : shift_left	<< func() (Attrib, error) {
+          if err := semantics.DoShift(); err != nil {
             return nil, err
           }
+          semantics.PushOpAt(semantics.SHIFTLEFT, X[0])
           return nil, nil
         }() >>`,
-		Id:         "Expression",
-		NTType:     32,
-		Index:      47,
-		NumSymbols: 3,
+		Id:         "OperatorShift",
+		NTType:     75,
+		Index:      139,
+		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          err := semantics.DoRelational()
-          if err != nil {
+          if err := semantics.DoShift(); err != nil {
             return nil, err
           }
+          semantics.PushOpAt(semantics.SHIFTLEFT, X[0])
           return nil, nil
         }()
 		},
 	},
 	ProdTabEntry{
-		String: `Expression : Exp	<< func() (Attrib, error) {
-        return X[0], nil
-      }() >>`,
-		Id:         "Expression",
-		NTType:     32,
-		Index:      48,
+		String: `OperatorShift : shift_right	<< func() (Attrib, error) {
+          if err := semantics.DoShift(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.SHIFTRIGHT, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "OperatorShift",
+		NTType:     75,
+		Index:      140,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        return X[0], nil
-      }()
+          if err := semantics.DoShift(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.SHIFTRIGHT, X[0])
+          return nil, nil
+        }()
 		},
 	},
 	ProdTabEntry{
 		String: `Operator : less_than	<< func() (Attrib, error) {
-          semantics.PushOp(semantics.MORETHAN)
+          semantics.PushOpAt(semantics.MORETHAN, X[0])
           return nil, nil
         }() >>`,
 		Id:         "Operator",
-		NTType:     33,
-		Index:      49,
+		NTType:     76,
+		Index:      141,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.PushOp(semantics.MORETHAN)
+          semantics.PushOpAt(semantics.MORETHAN, X[0])
           return nil, nil
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `Operator : more_than	<< func() (Attrib, error) {
-          semantics.PushOp(semantics.LESSTHAN)
+          semantics.PushOpAt(semantics.LESSTHAN, X[0])
           return nil, nil
         }() >>`,
 		Id:         "Operator",
-		NTType:     33,
-		Index:      50,
+		NTType:     76,
+		Index:      142,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.PushOp(semantics.LESSTHAN)
+          semantics.PushOpAt(semantics.LESSTHAN, X[0])
           return nil, nil
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `Operator : not_equal	<< func() (Attrib, error) {
-          semantics.PushOp(semantics.NOTEQUAL)
+          semantics.PushOpAt(semantics.NOTEQUAL, X[0])
           return nil, nil
         }() >>`,
 		Id:         "Operator",
-		NTType:     33,
-		Index:      51,
+		NTType:     76,
+		Index:      143,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.PushOpAt(semantics.NOTEQUAL, X[0])
+          return nil, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Operator : equal	<< func() (Attrib, error) {
+          semantics.PushOpAt(semantics.EQUAL, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "Operator",
+		NTType:     76,
+		Index:      144,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.PushOpAt(semantics.EQUAL, X[0])
+          return nil, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Operator : less_equal	<< func() (Attrib, error) {
+          semantics.PushOpAt(semantics.LESSEQUAL, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "Operator",
+		NTType:     76,
+		Index:      145,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+          semantics.PushOpAt(semantics.LESSEQUAL, X[0])
+          return nil, nil
+        }()
+		},
+	},
+	ProdTabEntry{
+		String: `Operator : more_equal	<< func() (Attrib, error) {
+          semantics.PushOpAt(semantics.MOREEQUAL, X[0])
+          return nil, nil
+        }() >>`,
+		Id:         "Operator",
+		NTType:     76,
+		Index:      146,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.PushOp(semantics.NOTEQUAL)
+          semantics.PushOpAt(semantics.MOREEQUAL, X[0])
           return nil, nil
         }()
 		},
@@ -829,8 +2347,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Exp : Term ExpList	<<  >>`,
 		Id:         "Exp",
-		NTType:     34,
-		Index:      52,
+		NTType:     77,
+		Index:      147,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -838,68 +2356,76 @@ var productionsTable = ProdTab{
 	},
 	ProdTabEntry{
 		String: `ExpList : OperatorAdd Term ExpList	<< func() (Attrib, error) {
-          _ = semantics.DoAddSub()
-          return nil, nil
+          err := semantics.DoAddSub()
+          return nil, err
         }() >>`,
 		Id:         "ExpList",
-		NTType:     35,
-		Index:      53,
+		NTType:     78,
+		Index:      148,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          _ = semantics.DoAddSub()
-          return nil, nil
+          err := semantics.DoAddSub()
+          return nil, err
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `ExpList : "empty"	<< func() (Attrib, error) {
-          _ = semantics.DoAddSub()
-          return nil, nil
+          err := semantics.DoAddSub()
+          return nil, err
         }() >>`,
 		Id:         "ExpList",
-		NTType:     35,
-		Index:      54,
+		NTType:     78,
+		Index:      149,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          _ = semantics.DoAddSub()
-          return nil, nil
+          err := semantics.DoAddSub()
+          return nil, err
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `OperatorAdd : add	<< func() (Attrib, error) {
-          semantics.DoAddSub()
-          semantics.PushOp(semantics.ADD)
+          if err := semantics.DoAddSub(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.ADD, X[0])
           return nil, nil
         }() >>`,
 		Id:         "OperatorAdd",
-		NTType:     36,
-		Index:      55,
+		NTType:     79,
+		Index:      150,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.DoAddSub()
-          semantics.PushOp(semantics.ADD)
+          if err := semantics.DoAddSub(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.ADD, X[0])
           return nil, nil
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `OperatorAdd : rest	<< func() (Attrib, error) {
-          semantics.DoAddSub()
-          semantics.PushOp(semantics.REST)
+          if err := semantics.DoAddSub(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.REST, X[0])
           return nil, nil
         }() >>`,
 		Id:         "OperatorAdd",
-		NTType:     36,
-		Index:      56,
+		NTType:     79,
+		Index:      151,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.DoAddSub()
-          semantics.PushOp(semantics.REST)
+          if err := semantics.DoAddSub(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.REST, X[0])
           return nil, nil
         }()
 		},
@@ -907,8 +2433,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Term : Factor TermList	<<  >>`,
 		Id:         "Term",
-		NTType:     37,
-		Index:      57,
+		NTType:     80,
+		Index:      152,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -916,68 +2442,76 @@ var productionsTable = ProdTab{
 	},
 	ProdTabEntry{
 		String: `TermList : OperatorMul Factor TermList	<< func() (Attrib, error) {
-          _ = semantics.DoMulDiv()
-          return nil, nil
+          err := semantics.DoMulDiv()
+          return nil, err
         }() >>`,
 		Id:         "TermList",
-		NTType:     38,
-		Index:      58,
+		NTType:     81,
+		Index:      153,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          _ = semantics.DoMulDiv()
-          return nil, nil
+          err := semantics.DoMulDiv()
+          return nil, err
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `TermList : "empty"	<< func() (Attrib, error) {
-          _ = semantics.DoMulDiv()
-          return nil, nil
+          err := semantics.DoMulDiv()
+          return nil, err
         }() >>`,
 		Id:         "TermList",
-		NTType:     38,
-		Index:      59,
+		NTType:     81,
+		Index:      154,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          _ = semantics.DoMulDiv()
-          return nil, nil
+          err := semantics.DoMulDiv()
+          return nil, err
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `OperatorMul : multiply	<< func() (Attrib, error) {
-          semantics.DoMulDiv()
-          semantics.PushOp(semantics.MULTIPLY)
+          if err := semantics.DoMulDiv(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.MULTIPLY, X[0])
           return nil, nil
         }() >>`,
 		Id:         "OperatorMul",
-		NTType:     39,
-		Index:      60,
+		NTType:     82,
+		Index:      155,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.DoMulDiv()
-          semantics.PushOp(semantics.MULTIPLY)
+          if err := semantics.DoMulDiv(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.MULTIPLY, X[0])
           return nil, nil
         }()
 		},
 	},
 	ProdTabEntry{
 		String: `OperatorMul : divide	<< func() (Attrib, error) {
-          semantics.DoMulDiv()
-          semantics.PushOp(semantics.DIVIDE)
+          if err := semantics.DoMulDiv(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.DIVIDE, X[0])
           return nil, nil
         }() >>`,
 		Id:         "OperatorMul",
-		NTType:     39,
-		Index:      61,
+		NTType:     82,
+		Index:      156,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          semantics.DoMulDiv()
-          semantics.PushOp(semantics.DIVIDE)
+          if err := semantics.DoMulDiv(); err != nil {
+            return nil, err
+          }
+          semantics.PushOpAt(semantics.DIVIDE, X[0])
           return nil, nil
         }()
 		},
@@ -985,8 +2519,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : FakeBottom Expression CloseParen	<<  >>`,
 		Id:         "Factor",
-		NTType:     40,
-		Index:      62,
+		NTType:     83,
+		Index:      157,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -998,9 +2532,12 @@ var productionsTable = ProdTab{
         value := string(cteToken.Lit)
         tipo := "int"
 
-        // Si tiene punto es un float
+        // Si tiene punto es un float, si termina en n es bigint
         if strings.Contains(value, ".") {
           tipo = "float"
+        } else if strings.HasSuffix(value, "n") {
+          tipo = "bigint"
+          value = strings.TrimSuffix(value, "n")
         }
 
         // Agrega a pila operandos
@@ -1009,8 +2546,8 @@ var productionsTable = ProdTab{
         return cteToken, nil
       }() >>`,
 		Id:         "Factor",
-		NTType:     40,
-		Index:      63,
+		NTType:     83,
+		Index:      158,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
@@ -1018,15 +2555,40 @@ var productionsTable = ProdTab{
         value := string(cteToken.Lit)
         tipo := "int"
 
-        // Si tiene punto es un float
+        // Si tiene punto es un float, si termina en n es bigint
         if strings.Contains(value, ".") {
           tipo = "float"
+        } else if strings.HasSuffix(value, "n") {
+          tipo = "bigint"
+          value = strings.TrimSuffix(value, "n")
         }
 
         // Agrega a pila operandos
         semantics.PushOperandDebug(value, tipo)
 
         return cteToken, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : cte_string	<< func() (Attrib, error) {
+        // La constante guarda el literal con comillas; cada {expresión} se compila con otro parser
+        if err := semantics.HandleString(X[0]); err != nil {
+          return nil, err
+        }
+        return X[0], nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      159,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // La constante guarda el literal con comillas; cada {expresión} se compila con otro parser
+        if err := semantics.HandleString(X[0]); err != nil {
+          return nil, err
+        }
+        return X[0], nil
       }()
 		},
 	},
@@ -1048,8 +2610,8 @@ var productionsTable = ProdTab{
         return X[0], nil
       }() >>`,
 		Id:         "Factor",
-		NTType:     40,
-		Index:      64,
+		NTType:     83,
+		Index:      160,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
@@ -1067,14 +2629,182 @@ var productionsTable = ProdTab{
         semantics.PushOperandDebug(vs.Address, vs.Type)
 
         return X[0], nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : id dot id	<< func() (Attrib, error) {
+        // Miembro de un enum (Color.Red)
+        err := semantics.HandleEnumMember(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return X[2], nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      161,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Miembro de un enum (Color.Red)
+        err := semantics.HandleEnumMember(X[0], X[2])
+        if err != nil {
+          return nil, err
+        }
+        return X[2], nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : id FakeBottom CallArgs CloseParen	<< func() (Attrib, error) {
+        // Conversión a enum (Color(n)) o función predefinida (len(l))
+        err := semantics.HandleCallExpr(X[0], X[2].(int))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      162,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Conversión a enum (Color(n)) o función predefinida (len(l))
+        err := semantics.HandleCallExpr(X[0], X[2].(int))
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : id FakeBottom CloseParen	<< func() (Attrib, error) {
+        // Función sin argumentos que regresa un valor (f())
+        err := semantics.HandleCallExpr(X[0], 0)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      163,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Función sin argumentos que regresa un valor (f())
+        err := semantics.HandleCallExpr(X[0], 0)
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : id IndexOpen Expression IndexClose	<< func() (Attrib, error) {
+        // Elemento de lista (l[i]) o valor de mapa (m[k])
+        err := semantics.HandleIndex(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      164,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Elemento de lista (l[i]) o valor de mapa (m[k])
+        err := semantics.HandleIndex(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : int FakeBottom Expression CloseParen	<< func() (Attrib, error) {
+        // Conversión de enum o float a int (int(c), int(x))
+        err := semantics.HandleCast(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      165,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Conversión de enum o float a int (int(c), int(x))
+        err := semantics.HandleCast(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : bigint FakeBottom Expression CloseParen	<< func() (Attrib, error) {
+        // Conversión de int a bigint (bigint(n))
+        err := semantics.HandleCast(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      166,
+		NumSymbols: 4,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Conversión de int a bigint (bigint(n))
+        err := semantics.HandleCast(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `Factor : bit_not Factor	<< func() (Attrib, error) {
+        // Complemento de bits (~n)
+        err := semantics.HandleBitNot(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
+      }() >>`,
+		Id:         "Factor",
+		NTType:     83,
+		Index:      167,
+		NumSymbols: 2,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        // Complemento de bits (~n)
+        err := semantics.HandleBitNot(X[0])
+        if err != nil {
+          return nil, err
+        }
+        return nil, nil
       }()
 		},
 	},
 	ProdTabEntry{
 		String: `Factor : add Factor	<<  >>`,
 		Id:         "Factor",
-		NTType:     40,
-		Index:      65,
+		NTType:     83,
+		Index:      168,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1083,8 +2813,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Factor : rest Factor	<<  >>`,
 		Id:         "Factor",
-		NTType:     40,
-		Index:      66,
+		NTType:     83,
+		Index:      169,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1096,13 +2826,65 @@ var productionsTable = ProdTab{
         return nil, nil
       }() >>`,
 		Id:         "FakeBottom",
-		NTType:     41,
-		Index:      67,
+		NTType:     84,
+		Index:      170,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        semantics.PushOp(semantics.FAKEBOTTOM)
+        return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `CallArgs : Expression	<< 1, nil >>`,
+		Id:         "CallArgs",
+		NTType:     85,
+		Index:      171,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return 1, nil
+		},
+	},
+	ProdTabEntry{
+		String: `CallArgs : Expression comma CallArgs	<< X[2].(int) + 1, nil >>`,
+		Id:         "CallArgs",
+		NTType:     85,
+		Index:      172,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[2].(int) + 1, nil
+		},
+	},
+	ProdTabEntry{
+		String: `IndexOpen : l_square_par	<< func() (Attrib, error) {
+        semantics.PushOp(semantics.FAKEBOTTOM)
+        return nil, nil
+      }() >>`,
+		Id:         "IndexOpen",
+		NTType:     86,
+		Index:      173,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
         semantics.PushOp(semantics.FAKEBOTTOM)
         return nil, nil
+      }()
+		},
+	},
+	ProdTabEntry{
+		String: `IndexClose : r_square_par	<< func() (Attrib, error) {
+        err := semantics.PopUntilFakeBottom()
+        return nil, err
+      }() >>`,
+		Id:         "IndexClose",
+		NTType:     87,
+		Index:      174,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return func() (Attrib, error) {
+        err := semantics.PopUntilFakeBottom()
+        return nil, err
       }()
 		},
 	},
@@ -1112,8 +2894,8 @@ var productionsTable = ProdTab{
         return nil, err
       }() >>`,
 		Id:         "CloseParen",
-		NTType:     42,
-		Index:      68,
+		NTType:     88,
+		Index:      175,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
@@ -1125,8 +2907,8 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Cte : cte_int	<<  >>`,
 		Id:         "Cte",
-		NTType:     43,
-		Index:      69,
+		NTType:     89,
+		Index:      176,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
@@ -1135,245 +2917,137 @@ var productionsTable = ProdTab{
 	ProdTabEntry{
 		String: `Cte : cte_float	<<  >>`,
 		Id:         "Cte",
-		NTType:     43,
-		Index:      70,
+		NTType:     89,
+		Index:      177,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return X[0], nil
 		},
 	},
 	ProdTabEntry{
-		String: `FEra : id	<< func() (Attrib, error) {
-        token, ok := X[0].(*token.Token)
-        if !ok {
-            return nil, fmt.Errorf("internal error: expected token for function name")
-        }
-
-        // Buscar si existe el nombre de la función
-        name := string(token.Lit)
-        raw, exists := semantics.FunctionDirectory.Get(name)
-        if !exists {
-            return nil, fmt.Errorf("error: función '%s' no declarada", name)
-        }
-
-        // Verifica que sea una estructura de función válida
-        if _, ok := raw.(semantics.FunctionStructure); !ok {
-            return nil, fmt.Errorf("internal error: entry for function '%s' is not a FunctionStructure", name)
-        }
-
-        // Genera ERA
-        semantics.HandleFEra(X[0])
-
-        return X[0], nil
-      }() >>`,
+		String: `Cte : cte_bigint	<<  >>`,
+		Id:         "Cte",
+		NTType:     89,
+		Index:      178,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return X[0], nil
+		},
+	},
+	ProdTabEntry{
+		String: `FEra : id	<< semantics.HandleFEra(X[0]) >>`,
 		Id:         "FEra",
-		NTType:     44,
-		Index:      71,
+		NTType:     90,
+		Index:      179,
 		NumSymbols: 1,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return func() (Attrib, error) {
-        token, ok := X[0].(*token.Token)
-        if !ok {
-            return nil, fmt.Errorf("internal error: expected token for function name")
-        }
-
-        // Buscar si existe el nombre de la función
-        name := string(token.Lit)
-        raw, exists := semantics.FunctionDirectory.Get(name)
-        if !exists {
-            return nil, fmt.Errorf("error: función '%s' no declarada", name)
-        }
-
-        // Verifica que sea una estructura de función válida
-        if _, ok := raw.(semantics.FunctionStructure); !ok {
-            return nil, fmt.Errorf("internal error: entry for function '%s' is not a FunctionStructure", name)
-        }
-
-        // Genera ERA
-        semantics.HandleFEra(X[0])
-
-        return X[0], nil
-      }()
+			return semantics.HandleFEra(X[0])
 		},
 	},
 	ProdTabEntry{
 		String: `FCall : FEra l_round_par FCallList r_round_par semicolon	<< func() (Attrib, error) {
-        fnTok := X[0].(*token.Token)
-        name := string(fnTok.Lit)
-
-        // Obtiene definición de la función
-        raw, _ := semantics.FunctionDirectory.Get(name)
-        fs := raw.(semantics.FunctionStructure)
-
-        // Datos parametros
-        n := len(fs.Parameters)
-        args, _ := X[2].([]Attrib)
-        
-        // Verifica aridad usando las pilas
-        if len(args) != n {
-            return nil, fmt.Errorf(
-                "error: función '%s' espera %d argumentos, recibió %d",
-                name, n, len(args),
-            )
-        }
-
-        // Saca argumentos de las pilas (en orden inverso -> derecha a izquierda)
-        for i := n - 1; i >= 0; i-- {
-            addr, _ := semantics.PilaO.Pop()       // Direccion
-            tipoRaw, _ := semantics.PTypes.Pop()   // Tipo
-            tipo, _ := tipoRaw.(string)
-            
-            // Verifica tipo
-            expectedType := fs.Parameters[i].Type
-            if tipo != expectedType {
-                return nil, fmt.Errorf(
-                    "error: parámetro %d tipo incorrecto, esperaba %s, obtuvo %s",
-                    n-i, expectedType, tipo,
-                )
-            }
-            
-            // Genera PARAMETER con índice (i+1)
-            semantics.PushQuad(semantics.PARAMETER, addr, "_", i+1)
+        // Escoge la versión de la función según los tipos y nombres de los argumentos
+        err := semantics.HandleFCall(X[0], X[2].([]string))
+        if err != nil {
+          return nil, err
         }
 
-        // Genera GOSUB
-        semantics.PushQuad(semantics.GOSUB, name, "_", fs.StartQuad)
-
         return X[0], nil
       }() >>`,
 		Id:         "FCall",
-		NTType:     45,
-		Index:      72,
+		NTType:     91,
+		Index:      180,
 		NumSymbols: 5,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-        fnTok := X[0].(*token.Token)
-        name := string(fnTok.Lit)
-
-        // Obtiene definición de la función
-        raw, _ := semantics.FunctionDirectory.Get(name)
-        fs := raw.(semantics.FunctionStructure)
-
-        // Datos parametros
-        n := len(fs.Parameters)
-        args, _ := X[2].([]Attrib)
-        
-        // Verifica aridad usando las pilas
-        if len(args) != n {
-            return nil, fmt.Errorf(
-                "error: función '%s' espera %d argumentos, recibió %d",
-                name, n, len(args),
-            )
-        }
-
-        // Saca argumentos de las pilas (en orden inverso -> derecha a izquierda)
-        for i := n - 1; i >= 0; i-- {
-            addr, _ := semantics.PilaO.Pop()       // Direccion
-            tipoRaw, _ := semantics.PTypes.Pop()   // Tipo
-            tipo, _ := tipoRaw.(string)
-            
-            // Verifica tipo
-            expectedType := fs.Parameters[i].Type
-            if tipo != expectedType {
-                return nil, fmt.Errorf(
-                    "error: parámetro %d tipo incorrecto, esperaba %s, obtuvo %s",
-                    n-i, expectedType, tipo,
-                )
-            }
-            
-            // Genera PARAMETER con índice (i+1)
-            semantics.PushQuad(semantics.PARAMETER, addr, "_", i+1)
+        // Escoge la versión de la función según los tipos y nombres de los argumentos
+        err := semantics.HandleFCall(X[0], X[2].([]string))
+        if err != nil {
+          return nil, err
         }
 
-        // Genera GOSUB
-        semantics.PushQuad(semantics.GOSUB, name, "_", fs.StartQuad)
-
         return X[0], nil
       }()
 		},
 	},
 	ProdTabEntry{
-		String: `FCallList : Expression FCallListTail	<< func() (Attrib, error) {
-          first := X[0].(Attrib)
-          tail, _ := X[1].([]Attrib)
+		String: `FCallList : FCallArg FCallListTail	<< func() (Attrib, error) {
+          tail, _ := X[1].([]string)
 
           // Regresa primer argumento con su lista acomulada de tail
-          return append([]Attrib{first}, tail...), nil
+          return append([]string{X[0].(string)}, tail...), nil
         }() >>`,
 		Id:         "FCallList",
-		NTType:     46,
-		Index:      73,
+		NTType:     92,
+		Index:      181,
 		NumSymbols: 2,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          first := X[0].(Attrib)
-          tail, _ := X[1].([]Attrib)
+          tail, _ := X[1].([]string)
 
           // Regresa primer argumento con su lista acomulada de tail
-          return append([]Attrib{first}, tail...), nil
+          return append([]string{X[0].(string)}, tail...), nil
         }()
 		},
 	},
 	ProdTabEntry{
-		String: `FCallList : "empty"	<< []Attrib{}, nil >>`,
+		String: `FCallList : "empty"	<< []string{}, nil >>`,
 		Id:         "FCallList",
-		NTType:     46,
-		Index:      74,
+		NTType:     92,
+		Index:      182,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return []Attrib{}, nil
+			return []string{}, nil
 		},
 	},
 	ProdTabEntry{
-		String: `FCallListTail : comma Expression FCallListTail	<< func() (Attrib, error) {
-          if X[1] == nil {
-            return nil, fmt.Errorf("FCallListTail error: argumento nulo en expresión")
-          }
-
-          arg := X[1].(Attrib)
-
-          var more []Attrib
-          if X[2] != nil {
-            more = X[2].([]Attrib)
-          } else {
-            more = []Attrib{}
-          }
+		String: `FCallListTail : comma FCallArg FCallListTail	<< func() (Attrib, error) {
+          tail, _ := X[2].([]string)
 
           // Regresa argumentos de la cola
-          return append([]Attrib{arg}, more...), nil
+          return append([]string{X[1].(string)}, tail...), nil
         }() >>`,
 		Id:         "FCallListTail",
-		NTType:     47,
-		Index:      75,
+		NTType:     93,
+		Index:      183,
 		NumSymbols: 3,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
 			return func() (Attrib, error) {
-          if X[1] == nil {
-            return nil, fmt.Errorf("FCallListTail error: argumento nulo en expresión")
-          }
-
-          arg := X[1].(Attrib)
-
-          var more []Attrib
-          if X[2] != nil {
-            more = X[2].([]Attrib)
-          } else {
-            more = []Attrib{}
-          }
+          tail, _ := X[2].([]string)
 
           // Regresa argumentos de la cola
-          return append([]Attrib{arg}, more...), nil
+          return append([]string{X[1].(string)}, tail...), nil
         }()
 		},
 	},
 	ProdTabEntry{
-		String: `FCallListTail : "empty"	<< []Attrib{}, nil >>`,
+		String: `FCallListTail : "empty"	<< []string{}, nil >>`,
 		Id:         "FCallListTail",
-		NTType:     47,
-		Index:      76,
+		NTType:     93,
+		Index:      184,
 		NumSymbols: 0,
 		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
-			return []Attrib{}, nil
+			return []string{}, nil
+		},
+	},
+	ProdTabEntry{
+		String: `FCallArg : Expression	<< "", nil >>`,
+		Id:         "FCallArg",
+		NTType:     94,
+		Index:      185,
+		NumSymbols: 1,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return "", nil
+		},
+	},
+	ProdTabEntry{
+		String: `FCallArg : id colon Expression	<< string(X[0].(*token.Token).Lit), nil >>`,
+		Id:         "FCallArg",
+		NTType:     94,
+		Index:      186,
+		NumSymbols: 3,
+		ReduceFunc: func(X []Attrib, C interface{}) (Attrib, error) {
+			return string(X[0].(*token.Token).Lit), nil
 		},
 	},
 }
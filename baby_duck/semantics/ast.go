@@ -3,6 +3,10 @@ package semantics
 import (
 	"baby_duck/token"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // ------------------------------------------ LIMPIAR ------------------------------------------
@@ -12,12 +16,24 @@ func ResetSemanticState() {
 	// Limpia diccionarios
 	ResetVars()
 	FunctionDirectory = NewDictionary()
+	EnumDirectory = NewDictionary()
+	TestDirectory = NewDictionary()
 
 	// Limpia pilas y cuadruplos
 	ResetStacks()
 
 	// Limpia direcciones
 	ResetMemory()
+
+	// Limpia valores por defecto pendientes
+	paramDefaults = map[string]int{}
+	generatorYield = ""
+	SourceText = nil
+	requires, ensures, resultNames = nil, nil, nil
+	InitStart = -1
+	setupStart, setupEnd = 0, 0
+	returnFunction, pendingReturns, openTries = "", nil, 0
+	interpolating = 0
 }
 
 // -------------------------------------------- PROGRAM --------------------------------------------
@@ -52,9 +68,11 @@ func HandlePHeader(idToken interface{}) (int, error) {
 // HandlePBody: Maneja cuerpo programa (backpatching)
 func HandlePBody(gotoMainQuad interface{}) error {
 	// Registra la función main
-	if err := RegisterFunction("main"); err != nil {
+	mainKey, err := RegisterFunction("main", nil)
+	if err != nil {
 		return err
 	}
+	CurrentFunction = "main"
 
 	// Converte indice a entero
 	quadIndex, ok := gotoMainQuad.(int)
@@ -63,7 +81,7 @@ func HandlePBody(gotoMainQuad interface{}) error {
 	}
 
 	// Obtiene la entrada
-	raw, exists := FunctionDirectory.Get("main")
+	raw, exists := FunctionDirectory.Get(mainKey)
 	if !exists {
 		return fmt.Errorf("error: función 'main' no encontrada en el directorio de funciones")
 	}
@@ -74,8 +92,18 @@ func HandlePBody(gotoMainQuad interface{}) error {
 	if quadIndex < 0 || quadIndex >= len(Quads) {
 		return fmt.Errorf("error: índice de cuadruplo GOTO a main (%d) fuera de rango", quadIndex)
 	}
+	// Con bloque init el programa empieza ahí (init ya creó las listas globales)
+	if InitStart >= 0 {
+		Quads[quadIndex].Result = InitStart
+		return nil
+	}
 	Quads[quadIndex].Result = startMain
 
+	// Crea las listas globales al inicio de main
+	setupStart = len(Quads)
+	EmitAllocations(Scopes.global, nil)
+	setupEnd = len(Quads)
+
 	return nil
 }
 
@@ -111,11 +139,17 @@ func VarDeclaration(ids []string, tipo string) error {
 		}
 
 		// Elegir el subsegmento de variable
-		switch tipo {
-		case "int":
+		switch {
+		case tipo == "int" || IsEnum(tipo): // Los enums se guardan como int
 			dir, err = segmento.Ints.GetNext()
-		case "float":
+		case tipo == "float":
 			dir, err = segmento.Floats.GetNext()
+		case tipo == "bigint":
+			dir, err = segmento.BigInts.GetNext()
+		case tipo == "string":
+			dir, err = segmento.Strings.GetNext()
+		case IsRefType(tipo): // Las listas guardan una referencia al heap
+			dir, err = segmento.Refs.GetNext()
 		default:
 			return fmt.Errorf("tipo no soportado: %s", tipo)
 		}
@@ -168,25 +202,200 @@ func HandleVarDecl(ids interface{}, typeToken interface{}) error {
 	}
 	tipo := string(tipoToken.Lit)
 
+	// Un id como tipo debe ser un enum declarado (var c: Colour; con error de dedo)
+	if !IsStorable(tipo) {
+		return errorAt(tipoToken, fmt.Errorf("error: tipo '%s' no declarado", tipo))
+	}
+
 	// Si es correcto, pasa datos
 	return VarDeclaration(idList, tipo)
 }
 
+// -------------------------------------------- ENUMS --------------------------------------------
+
+// HandleEnumDecl: Registra un enum con sus miembros (constantes int en su propio espacio de nombres)
+func HandleEnumDecl(idToken, members interface{}) error {
+	name := string(idToken.(*token.Token).Lit)
+	memberList := members.([]string)
+
+	// El nombre no puede chocar con otro tipo
+	if name == "int" || name == "float" || IsEnum(name) {
+		return fmt.Errorf("error: tipo '%s' ya declarado", name)
+	}
+
+	// Miembros sin repetir
+	seen := map[string]bool{}
+	for _, member := range memberList {
+		if seen[member] {
+			return fmt.Errorf("error: miembro '%s' repetido en enum '%s'", member, name)
+		}
+		seen[member] = true
+	}
+
+	EnumDirectory.Put(name, EnumStructure{Name: name, Members: memberList})
+	return nil
+}
+
+// LookupEnum: Busca un enum por nombre
+func LookupEnum(name string) (EnumStructure, bool) {
+	raw, exists := EnumDirectory.Items[name]
+	if !exists {
+		return EnumStructure{}, false
+	}
+	return raw.(EnumStructure), true
+}
+
+// IsEnum: Indica si un tipo es un enum declarado
+func IsEnum(tipo string) bool {
+	_, exists := LookupEnum(tipo)
+	return exists
+}
+
+// HandleEnumMember: Agrega a las pilas la constante de Enum.Miembro con el tipo del enum
+func HandleEnumMember(enumToken, memberToken interface{}) error {
+	enumName := string(enumToken.(*token.Token).Lit)
+	member := string(memberToken.(*token.Token).Lit)
+
+	enum, exists := LookupEnum(enumName)
+	if !exists {
+		return fmt.Errorf("error: enum '%s' no declarado", enumName)
+	}
+
+	for i, m := range enum.Members {
+		if m == member {
+			PilaO.Push(GetConstAddress(strconv.Itoa(i), "int"))
+			PTypes.Push(enumName)
+			return nil
+		}
+	}
+	return fmt.Errorf("error: enum '%s' no tiene miembro '%s'", enumName, member)
+}
+
+// HandleCast: Conversión explícita entre un enum y int (int(c), Color(n)), de float a int truncando (int(x))
+// o de int a bigint (bigint(n))
+func HandleCast(typeToken interface{}) error {
+	target := string(typeToken.(*token.Token).Lit)
+	valueAddr, _ := PilaO.Pop()
+	valueType, _ := PTypes.Pop()
+	source := valueType.(string)
+
+	switch {
+	case target == "bigint" && (source == "int" || source == "bigint"):
+		// Copia a un temporal bigint, la VM convierte el valor al guardarlo
+		temp, err := NewTemp("bigint")
+		if err != nil {
+			return err
+		}
+		PushQuad(ASSIGN, valueAddr, "_", temp)
+		valueAddr = temp
+	case target == "int" && source == "float":
+		// Trunca hacia cero en un temporal int
+		temp, err := NewTemp("int")
+		if err != nil {
+			return err
+		}
+		PushQuadAt(PosOf(typeToken), TRUNC, valueAddr, "_", temp)
+		valueAddr = temp
+	case target == "int" && (source == "int" || IsEnum(source)):
+	case IsEnum(target) && source == "int":
+		// Un int solo es enum si es la posición de un miembro: constante se revisa aquí, lo demás en la VM
+		enum, _ := LookupEnum(target)
+		if n, isConst := ConstIntValue(valueAddr); isConst {
+			if n < 0 || n >= len(enum.Members) {
+				return fmt.Errorf("error: %s(%d) fuera de rango, '%s' tiene %d miembros", target, n, target, len(enum.Members))
+			}
+			break
+		}
+		PushQuadAt(PosOf(typeToken), ENUMCHECK, valueAddr, target, "_")
+	case IsEnum(target) && source == target:
+	case IsEnum(target) || target == "int" || target == "bigint":
+		return fmt.Errorf("error: no se puede convertir %s a %s", source, target)
+	default:
+		return fmt.Errorf("error: '%s' no es un tipo para conversión", target)
+	}
+
+	// El valor no cambia, solo su tipo
+	PilaO.Push(valueAddr)
+	PTypes.Push(target)
+	return nil
+}
+
+// IsStorable: Indica si el tipo tiene segmento de variables
+func IsStorable(tipo string) bool {
+	return tipo == "int" || tipo == "float" || tipo == "bigint" || tipo == "string" || IsEnum(tipo) || IsRefType(tipo)
+}
+
+// CheckAssignType: int y float se asignan entre sí (float → int trunca, ver EmitAssign), un int cabe en bigint;
+// los demás tipos deben ser iguales
+func CheckAssignType(targetType, valueType string) error {
+	numeric := func(tipo string) bool { return tipo == "int" || tipo == "float" }
+	if targetType == valueType || (numeric(targetType) && numeric(valueType)) ||
+		(targetType == "bigint" && valueType == "int") {
+		return nil
+	}
+	return fmt.Errorf("error: no se puede asignar %s a %s sin conversión", valueType, targetType)
+}
+
+// EmitAssign: Genera la asignación convirtiendo el valor al tipo del destino
+func EmitAssign(value interface{}, valueType, targetType string, target int) {
+	PushQuad(assignOper(valueType, targetType), value, "_", target)
+}
+
+// assignOper: Operador de asignación; un float en int se trunca y un int en float se convierte para que cada segmento guarde su tipo
+func assignOper(valueType, targetType string) int {
+	switch {
+	case targetType == "int" && valueType == "float":
+		return TRUNC
+	case targetType == "float" && valueType == "int":
+		return TOFLOAT
+	}
+	return ASSIGN
+}
+
+// -------------------------------------------- BITS --------------------------------------------
+
+// HandleBitNot: Complemento de bits de un int (~n)
+func HandleBitNot(notToken interface{}) error {
+	value, _ := PilaO.Pop()
+	valueType, _ := PTypes.Pop()
+	if valueType != "int" {
+		return fmt.Errorf("semántico: el operador ~ solo acepta int, recibió %v", valueType)
+	}
+
+	temp, err := NewTemp("int")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(notToken), BITNOT, value, "_", temp)
+	PilaO.Push(temp)
+	PTypes.Push("int")
+	return nil
+}
+
 // -------------------------------------------- FUNCS --------------------------------------------
 
-// RegisterFunction: Crea la entrada de la función con nombre, retorno void
-func RegisterFunction(name string) error {
-	// Verifica si ya existe una función con el mismo nombre, marca error
-	if _, exists := FunctionDirectory.Get(name); exists {
-		return fmt.Errorf("error: función '%s' ya declarada", name)
+// RegisterFunction: Crea la entrada de la función con su firma, retorno void
+func RegisterFunction(name string, params []VariableStructure) (string, error) {
+	// Los nombres de funciones predefinidas (len, append...) están reservados
+	if IsBuiltin(name) {
+		return "", fmt.Errorf("error: '%s' es una función predefinida", name)
+	}
+
+	// La llave es el nombre con los tipos, así pueden existir sobrecargas
+	key := MangleName(name, params)
+
+	// Verifica si ya existe una función con la misma firma, marca error
+	if _, exists := FunctionDirectory.Get(key); exists {
+		return "", fmt.Errorf("error: función '%s' ya declarada", key)
 	}
 
 	// Crea una nueva tabla de variables locales para esta función
 	localTable := NewDictionary()
 
 	// Registra la función en el directorio
-	FunctionDirectory.Put(name, FunctionStructure{
+	FunctionDirectory.Put(key, FunctionStructure{
 		Name:       name,                  // Nombre
+		Signature:  key,                   // Nombre con tipos
 		Parameters: []VariableStructure{}, // Parametros (vacios)
 		VarTable:   localTable,            // Tabla local de variables
 		ParamCount: 0,                     // Numero param
@@ -196,7 +405,19 @@ func RegisterFunction(name string) error {
 
 	//fmt.Printf("[DEBUG] RegisterFunction: %d\n", len(Quads))
 
-	return nil
+	return key, nil
+}
+
+// MangleName: Nombre con los tipos de sus parámetros, p. ej. area(float,float)
+func MangleName(name string, params []VariableStructure) string {
+	types := make([]string, len(params))
+	for i, p := range params {
+		types[i] = p.Type
+		if p.Variadic {
+			types[i] = ListElemType(p.Type) + "..."
+		}
+	}
+	return name + "(" + strings.Join(types, ",") + ")"
 }
 
 // FuncDeclaration: Actualiza la entrada creada por RegisterFunction
@@ -246,22 +467,41 @@ func HandleFunctionHeader(idToken, paramsToken interface{}) (FuncInfo, error) {
 	name := string(token.Lit)
 	params := paramsToken.([]VariableStructure)
 
+	// Valores por defecto de los parámetros
+	defaults, err := collectDefaults(params)
+	if err != nil {
+		return FuncInfo{}, err
+	}
+
 	// Registra nombre función
-	if err := RegisterFunction(name); err != nil {
+	key, err := RegisterFunction(name, params)
+	if err != nil {
 		return FuncInfo{}, err
 	}
+	CurrentFunction = name
 
-	// Crea scope local y declara los parametros
+	raw, _ := FunctionDirectory.Get(key)
+	fs := raw.(FunctionStructure)
+	fs.Defaults = defaults
+	FunctionDirectory.Put(key, fs)
+
+	// Crea scope local y declara los parametros (ahí reciben su dirección)
 	Scopes.EnterScope()
-	for _, p := range params {
+	for i, p := range params {
 		if err := VarDeclaration([]string{p.Name}, p.Type); err != nil {
 			Scopes.ExitScope()
 			return FuncInfo{}, err
 		}
+		raw, _ := Scopes.Current().Get(p.Name)
+		params[i].Address = raw.(VariableStructure).Address
 	}
 
+	// Las cláusulas requires/ensures se compilan después del encabezado
+	BeginContracts()
+	BeginReturns(key)
+
 	// Retorna nombre función con sus parametros
-	return FuncInfo{Name: name, Params: params}, nil
+	return FuncInfo{Name: key, Params: params}, nil
 }
 
 // HandleFunctionHeaderTwo: Obtiene información importante
@@ -275,10 +515,15 @@ func HandleFunctionHeaderTwo(funcInfo interface{}) (FuncInfo, error) {
 		Scopes.ExitScope()
 		return FuncInfo{}, err
 	}
+
+	// Crea las listas locales (los parámetros llegan como referencia) y revisa los requires
+	EmitAllocations(Scopes.Current(), info.Params)
+	EmitRequires()
 	return info, nil
 }
 
-// HandleFEra: Genera ERA
+// HandleFEra: Verifica que la función exista; el ERA se genera en emitCall, después de los argumentos
+// (un argumento puede ser otra llamada con su propio ERA)
 func HandleFEra(idToken interface{}) (interface{}, error) {
 	// Extrae nombre de la función
 	fnTok, ok := idToken.(*token.Token)
@@ -287,22 +532,241 @@ func HandleFEra(idToken interface{}) (interface{}, error) {
 	}
 	name := string(fnTok.Lit)
 
-	// Comprueba que la función exista
-	raw, exists := FunctionDirectory.Get(name)
-	if !exists {
+	// Las funciones predefinidas no usan ERA
+	if IsBuiltin(name) {
+		return fnTok, nil
+	}
+
+	// Comprueba que exista al menos una versión de la función
+	if len(Overloads(name)) == 0 {
 		return nil, fmt.Errorf("error: función '%s' no declarada", name)
 	}
+	return fnTok, nil
+}
 
-	// Calcula tamaño y genera ERA
-	fs := raw.(FunctionStructure)
-	size := fs.LocalVarCount + fs.TempCount + fs.ParamCount
-	PushQuad(ERA, "_", "_", size)
+// HandleFCall: Escoge la versión de la función y genera PARAMETER y GOSUB
+func HandleFCall(idToken interface{}, argNames []string) error {
+	_, err := emitCall(idToken, argNames)
+	return err
+}
 
-	return fnTok, nil
+// emitCall: Genera la llamada y regresa la versión escogida (vacía si es una función predefinida)
+func emitCall(idToken interface{}, argNames []string) (FunctionStructure, error) {
+	name := string(idToken.(*token.Token).Lit)
+	argCount := len(argNames)
+
+	// Los argumentos con nombre van al final
+	for i := 1; i < argCount; i++ {
+		if argNames[i-1] != "" && argNames[i] == "" {
+			return FunctionStructure{}, fmt.Errorf("error: argumento posicional después de argumentos con nombre en '%s'", name)
+		}
+	}
+
+	// Saca argumentos de las pilas (en orden inverso -> derecha a izquierda)
+	addrs := make([]interface{}, argCount)
+	types := make([]string, argCount)
+	for i := argCount - 1; i >= 0; i-- {
+		addrs[i], _ = PilaO.Pop()
+		tipoRaw, _ := PTypes.Pop()
+		types[i], _ = tipoRaw.(string)
+	}
+
+	// Funciones predefinidas usadas como estatuto (append...)
+	if IsBuiltin(name) {
+		return FunctionStructure{}, HandleBuiltinStatement(idToken, addrs, types, argNames)
+	}
+
+	fs, binding, err := ResolveOverload(name, types, argNames)
+	if err != nil {
+		return FunctionStructure{}, err
+	}
+	if fs.Yields != "" {
+		return FunctionStructure{}, fmt.Errorf("error: el generador '%s' solo se puede recorrer con for-in", name)
+	}
+	if err := CheckInitCall(idToken, fs); err != nil {
+		return FunctionStructure{}, err
+	}
+
+	// ERA con el tamaño de la versión elegida, ya con los argumentos evaluados
+	PushQuad(ERA, "_", "_", fs.LocalVarCount+fs.TempCount+fs.ParamCount)
+	if err := EmitParameters(fs, binding, addrs); err != nil {
+		return FunctionStructure{}, err
+	}
+
+	// Genera GOSUB a la firma para llegar al cuerpo correcto
+	PushQuad(GOSUB, fs.Signature, "_", fs.StartQuad)
+	return fs, nil
+}
+
+// EmitParameters: Genera PARAMETER con índice (i+1), con el argumento o su valor por defecto;
+// los argumentos extra de un variádico se juntan en una lista nueva
+func EmitParameters(fs FunctionStructure, binding []int, addrs []interface{}) error {
+	for i := len(fs.Parameters) - 1; i >= 0; i-- {
+		param := fs.Parameters[i]
+		switch {
+		case param.Variadic:
+			list, err := NewTemp(param.Type)
+			if err != nil {
+				return err
+			}
+			PushQuad(NEW, param.Type, "_", list)
+			for _, arg := range binding[i:] {
+				PushQuad(LISTAPPEND, list, addrs[arg], "_")
+			}
+			PushQuad(PARAMETER, list, "_", i+1)
+		case binding[i] == -1:
+			PushQuad(PARAMETER, fs.Defaults[i], "_", i+1)
+		default:
+			PushQuad(PARAMETER, addrs[binding[i]], "_", i+1)
+		}
+	}
+	return nil
+}
+
+// Overloads: Todas las versiones de una función, ordenadas por firma
+func Overloads(name string) []FunctionStructure {
+	var list []FunctionStructure
+	for _, raw := range FunctionDirectory.Items {
+		if fs, ok := raw.(FunctionStructure); ok && fs.Name == name {
+			list = append(list, fs)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Signature < list[j].Signature })
+	return list
+}
+
+// CanWiden: Un argumento se acepta si es del mismo tipo o si el cubo lo promueve (int → float)
+func CanWiden(from, to string) bool {
+	if from == to {
+		return true
+	}
+	res, err := GetResultType(to, from, "+")
+	return err == nil && res == to
 }
 
-// HandleFunction: Maneja el cierre de una función
-func HandleFunction(funcInfo interface{}) error {
+// ResolveOverload: Escoge la versión que acepta los argumentos con menos conversiones
+// y regresa qué argumento recibe cada parámetro (-1 = valor por defecto)
+func ResolveOverload(name string, argTypes, argNames []string) (FunctionStructure, []int, error) {
+	candidates := Overloads(name)
+	if len(candidates) == 0 {
+		return FunctionStructure{}, nil, fmt.Errorf("error: función '%s' no declarada", name)
+	}
+
+	// Con una sola versión se reportan directamente los errores de aridad, nombre y tipo
+	if len(candidates) == 1 {
+		binding, _, err := bindArguments(candidates[0], argTypes, argNames)
+		return candidates[0], binding, err
+	}
+
+	// Busca la de menor costo; si empatan dos, la llamada es ambigua
+	best, bestCost, ambiguous := -1, 0, false
+	var bestBinding []int
+	for i, fs := range candidates {
+		binding, cost, err := bindArguments(fs, argTypes, argNames)
+		if err != nil {
+			continue
+		}
+		if best == -1 || cost < bestCost {
+			best, bestCost, bestBinding, ambiguous = i, cost, binding, false
+		} else if cost == bestCost {
+			ambiguous = true
+		}
+	}
+
+	call := name + "(" + strings.Join(argTypes, ",") + ")"
+	if best == -1 {
+		return FunctionStructure{}, nil, fmt.Errorf("error: ninguna versión de '%s' acepta la llamada %s", name, call)
+	}
+	if ambiguous {
+		return FunctionStructure{}, nil, fmt.Errorf("error: llamada ambigua %s", call)
+	}
+	return candidates[best], bestBinding, nil
+}
+
+// bindArguments: Asigna cada argumento (posicional o con nombre) a su parámetro
+// y cuenta cuántos necesitan promoverse; los extra de un variádico quedan al final de binding
+func bindArguments(fs FunctionStructure, argTypes, argNames []string) ([]int, int, error) {
+	params := fs.Parameters
+	variadic := len(params) > 0 && params[len(params)-1].Variadic
+	if variadic {
+		params = params[:len(params)-1]
+	}
+	binding := make([]int, len(params))
+	for i := range binding {
+		binding[i] = -1
+	}
+
+	cost := 0
+	for i, argName := range argNames {
+		index := i
+		if argName != "" {
+			index = paramIndex(params, argName)
+			if index == -1 {
+				return nil, 0, fmt.Errorf("error: función '%s' no tiene parámetro '%s'", fs.Name, argName)
+			}
+		} else if i >= len(params) && variadic {
+			// Argumento extra: cada uno se revisa contra el tipo de los elementos
+			elem := ListElemType(fs.Parameters[len(params)].Type)
+			if !CanWiden(argTypes[i], elem) {
+				return nil, 0, fmt.Errorf(
+					"error: argumento %d tipo incorrecto, esperaba %s, obtuvo %s",
+					i+1, elem, argTypes[i],
+				)
+			}
+			if argTypes[i] != elem {
+				cost++
+			}
+			binding = append(binding, i)
+			continue
+		} else if i >= len(params) {
+			return nil, 0, fmt.Errorf(
+				"error: función '%s' espera %d argumentos, recibió %d",
+				fs.Name, len(params), len(argTypes),
+			)
+		}
+
+		if binding[index] != -1 {
+			return nil, 0, fmt.Errorf("error: parámetro '%s' recibió más de un valor", params[index].Name)
+		}
+		binding[index] = i
+	}
+
+	for i, param := range params {
+		// Sin argumento se usa el valor por defecto
+		if binding[i] == -1 {
+			if _, hasDefault := fs.Defaults[i]; !hasDefault {
+				return nil, 0, fmt.Errorf("error: falta el argumento '%s' en la llamada a '%s'", param.Name, fs.Name)
+			}
+			continue
+		}
+
+		argType := argTypes[binding[i]]
+		if argType == param.Type {
+			continue
+		}
+		if !CanWiden(argType, param.Type) {
+			return nil, 0, fmt.Errorf(
+				"error: parámetro %d tipo incorrecto, esperaba %s, obtuvo %s",
+				i+1, param.Type, argType,
+			)
+		}
+		cost++
+	}
+	return binding, cost, nil
+}
+
+// paramIndex: Posición del parámetro con ese nombre (-1 si no existe)
+func paramIndex(params []VariableStructure, name string) int {
+	for i, param := range params {
+		if param.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// HandleFunction: Maneja el cierre de una función (endToken es el ']' que la cierra)
+func HandleFunction(funcInfo, endToken interface{}) error {
 	info, ok := funcInfo.(FuncInfo)
 	if !ok {
 		return fmt.Errorf("error interno: información de función inválida")
@@ -329,8 +793,13 @@ func HandleFunction(funcInfo interface{}) error {
 
 	// Reinicia contador para la siguiente función
 	TempVar = 0
+	generatorYield = ""
 
-	// Genera ENDFUNC
+	// Los return saltan aquí, después se revisan los ensures y se genera ENDFUNC
+	if err := PatchReturns(fs, endToken); err != nil {
+		return err
+	}
+	EmitEnsures()
 	PushQuad(ENDFUNC, "_", "_", "_")
 
 	// Sale del scope local (borra las tablas solo existen dentro de ese scope)
@@ -354,36 +823,10 @@ func ValidateParams(params []VariableStructure) error {
 	return nil
 }
 
-// AssignAddressToParam: Asigna dirección de memoria
-func AssignAddressToParam(tipo string) (int, error) {
-	switch tipo {
-	case "int":
-		return memory.Local.Ints.GetNext()
-	case "float":
-		return memory.Local.Floats.GetNext()
-	default:
-		return 0, fmt.Errorf("tipo no soportado: %s", tipo)
-	}
-}
-
-// DeclareInCurrentScope: Decalra parametro en su scope
-func DeclareInCurrentScope(name, tipo string, address int) error {
-	scope := Scopes.Current()
-
-	// Crea estructura de variable y la agrega
-	scope.Put(name, VariableStructure{
-		Name:    name,
-		Type:    tipo,
-		Address: address,
-	})
-
-	// Mapea la direccion
-	AddressToName[address] = name
-	return nil
-}
+var paramDefaults = map[string]int{} // Valores por defecto pendientes (nombre → dirección constante)
 
-// HandleParam: Procesa un parametro
-func HandleParam(idToken, typeToken interface{}) (VariableStructure, error) {
+// HandleParam: Procesa un parametro (la dirección se asigna al entrar al scope de la función)
+func HandleParam(idToken, typeToken, defaultToken interface{}) (VariableStructure, error) {
 	// Convierte a *token.Token (type, lit, pos) -> Nombre
 	nameTok, ok := idToken.(*token.Token)
 	if !ok {
@@ -400,18 +843,62 @@ func HandleParam(idToken, typeToken interface{}) (VariableStructure, error) {
 	name := string(nameTok.Lit)
 	tipo := string(tipoTok.Lit)
 
-	// Asigna dirección según tipo
-	dir, err := AssignAddressToParam(tipo)
-	if err != nil {
-		return VariableStructure{}, err
+	// Verifica que el tipo se pueda guardar
+	if !IsStorable(tipo) {
+		return VariableStructure{}, fmt.Errorf("tipo no soportado: %s", tipo)
 	}
 
-	// Declara en scope actual
-	if err := DeclareInCurrentScope(name, tipo, dir); err != nil {
-		return VariableStructure{}, err
+	// nums: int... recibe los argumentos extra en una lista
+	if cteTok, ok := defaultToken.(*token.Token); ok && string(cteTok.Lit) == "..." {
+		if tipo != "int" && tipo != "float" && tipo != "string" {
+			return VariableStructure{}, fmt.Errorf("error: el parámetro variádico '%s' debe ser int, float o string, no %s", name, tipo)
+		}
+		return VariableStructure{Name: name, Type: "list of " + tipo, Variadic: true}, nil
 	}
 
-	return VariableStructure{Name: name, Type: tipo, Address: dir}, nil
+	// Valor por defecto: constante que se pueda promover al tipo del parámetro
+	if cteTok, ok := defaultToken.(*token.Token); ok {
+		value := string(cteTok.Lit)
+		cteType := "int"
+		if strings.Contains(value, ".") {
+			cteType = "float"
+		} else if strings.HasSuffix(value, "n") {
+			cteType = "bigint"
+			value = strings.TrimSuffix(value, "n")
+		}
+		if !CanWiden(cteType, tipo) {
+			return VariableStructure{}, fmt.Errorf("error: valor por defecto de '%s' debe ser %s, es %s", name, tipo, cteType)
+		}
+		paramDefaults[name] = GetConstAddress(value, cteType)
+	}
+
+	return VariableStructure{Name: name, Type: tipo}, nil
+}
+
+// collectDefaults: Pasa los valores por defecto pendientes a índices de parámetro
+func collectDefaults(params []VariableStructure) (map[int]int, error) {
+	defaults := map[int]int{}
+	for i, param := range params {
+		// El variádico va al final y no se combina con valores por defecto
+		if param.Variadic {
+			if i != len(params)-1 {
+				return nil, fmt.Errorf("error: el parámetro variádico '%s' debe ser el último", param.Name)
+			}
+			if len(defaults) > 0 {
+				return nil, fmt.Errorf("error: el parámetro variádico '%s' no se puede combinar con valores por defecto", param.Name)
+			}
+			continue
+		}
+
+		if addr, ok := paramDefaults[param.Name]; ok {
+			defaults[i] = addr
+		} else if len(defaults) > 0 {
+			// Después de un parámetro con valor por defecto todos deben tenerlo
+			return nil, fmt.Errorf("error: parámetro '%s' sin valor por defecto después de uno con valor por defecto", param.Name)
+		}
+	}
+	paramDefaults = map[string]int{}
+	return defaults, nil
 }
 
 // -------------------------------------------- ASSIGN --------------------------------------------
@@ -426,14 +913,87 @@ func HandleAssign(idToken interface{}) error {
 	}
 
 	rightOp, _ := PilaO.Pop()            // Operando derecho
+	rightType, _ := PTypes.Pop()         // Tipo del operando derecho
 	raw, _ := Scopes.Current().Get(name) // Valor guardado en la tabla para nombre
 	vs := raw.(VariableStructure)        // Obtienes su info
 
+	// Un enum o bigint no se mezcla con otros tipos sin conversión
+	if err := CheckAssignType(vs.Type, rightType.(string)); err != nil {
+		return fmt.Errorf("%v en la asignación a '%s'", err, name)
+	}
+
 	// Haces cuadruplo
-	PushQuad(ASSIGN, rightOp, "_", vs.Address)
+	EmitAssign(rightOp, rightType.(string), vs.Type, vs.Address)
+	return nil
+}
+
+// HandleInferDecl: Maneja var x := expr (el tipo de x es el tipo de la expresión)
+func HandleInferDecl(idToken interface{}) error {
+	name := string(idToken.(*token.Token).Lit)
+
+	// No se puede redeclarar en el mismo scope
+	if _, exists := Scopes.Current().Items[name]; exists {
+		return fmt.Errorf("error: variable '%s' ya declarada", name)
+	}
+
+	rightOp, _ := PilaO.Pop()    // Valor inicial
+	rightType, _ := PTypes.Pop() // Tipo inferido
+	tipo := rightType.(string)
+
+	// Solo se infieren tipos que tienen segmento de variables
+	if !IsStorable(tipo) {
+		return fmt.Errorf("error: no se puede inferir '%s' con tipo %s (no se puede guardar en una variable)", name, tipo)
+	}
+
+	// Declara en el scope actual (global dentro de main, local dentro de una función)
+	if err := VarDeclaration([]string{name}, tipo); err != nil {
+		return err
+	}
+	raw, _ := Scopes.Current().Get(name)
+	PushQuad(ASSIGN, rightOp, "_", raw.(VariableStructure).Address)
+	return nil
+}
+
+// HandleCompoundAssign: Maneja x op= expr como x = x op expr
+func HandleCompoundAssign(idToken interface{}, op int) error {
+	name := string(idToken.(*token.Token).Lit)
+
+	// Verifica que variable fue declarada
+	raw, exists := Scopes.Current().Get(name)
+	if !exists {
+		return fmt.Errorf("error: variable '%s' no declarada", name)
+	}
+	vs := raw.(VariableStructure)
+
+	// Acomoda la variable debajo de la expresión ya evaluada (su dirección se lee una vez)
+	rightOp, _ := PilaO.Pop()
+	rightType, _ := PTypes.Pop()
+	PushOperandDebug(vs.Address, vs.Type)
+	PilaO.Push(rightOp)
+	PTypes.Push(rightType)
+
+	// Genera la operación con el cubo semántico, igual que en una expresión
+	PushOpAt(op, idToken)
+	if err := ProcessOperation([]int{op}, false); err != nil {
+		return err
+	}
+
+	// Asigna el temporal resultante a la variable
+	result, _ := PilaO.Pop()
+	resultType, _ := PTypes.Pop()
+	if err := CheckAssignType(vs.Type, resultType.(string)); err != nil {
+		return fmt.Errorf("%v en la asignación a '%s'", err, name)
+	}
+	EmitAssign(result, resultType.(string), vs.Type, vs.Address)
 	return nil
 }
 
+// HandleIncrement: Maneja x++ y x-- como x += 1 y x -= 1
+func HandleIncrement(idToken interface{}, op int) error {
+	PushOperandDebug("1", "int")
+	return HandleCompoundAssign(idToken, op)
+}
+
 // -------------------------------------------- CONDITIONAL --------------------------------------------
 
 // HandleConditionTail: Maneja final de condición
@@ -532,32 +1092,323 @@ func HandleCycleExpression() error {
 	return nil
 }
 
+// -------------------------------------------- TERNARY --------------------------------------------
+
+// HandleTernaryIf: Revisa la condición y salta a la rama falsa si no se cumple
+func HandleTernaryIf() error {
+	condAddr, _ := PilaO.Pop()  // Direccion
+	condType, _ := PTypes.Pop() // Tipo
+
+	if condType != "bool" {
+		return fmt.Errorf("error: condición de ?: debe ser booleana, recibió: %v", condType)
+	}
+
+	PushQuad(GOTOF, condAddr, "_", -1) // GOTOF direccion pendiente
+	PJumps.Push(len(Quads) - 1)        // Guarda posicion para backpatching
+	return nil
+}
+
+// HandleTernaryElse: Copia la rama verdadera al resultado (aún sin dirección) y brinca la falsa
+func HandleTernaryElse() error {
+	// La rama verdadera se queda en las pilas para conocer su tipo al final
+	trueAddr, err := PilaO.Peek()
+	if err != nil {
+		return fmt.Errorf("error interno: ?: sin rama verdadera (%v)", err)
+	}
+	PushQuad(ASSIGN, trueAddr, "_", -1)
+	assignQuad := len(Quads) - 1
+
+	PushQuad(GOTO, "_", "_", -1) // Salto al final
+	endJump := len(Quads) - 1
+
+	// Completa el GOTOF para que llegue a la rama falsa
+	falseJumpRaw, err := PJumps.Pop()
+	if err != nil {
+		return fmt.Errorf("error interno: ?: sin salto pendiente (%v)", err)
+	}
+	Quads[falseJumpRaw.(int)].Result = len(Quads)
+
+	PJumps.Push(assignQuad)
+	PJumps.Push(endJump)
+	return nil
+}
+
+// HandleTernary: Une los tipos de las ramas, asigna el temporal y completa los saltos
+func HandleTernary() error {
+	falseAddr, _ := PilaO.Pop()
+	falseType, _ := PTypes.Pop()
+	PilaO.Pop()
+	trueType, _ := PTypes.Pop()
+
+	resType, err := unifyTypes(trueType.(string), falseType.(string))
+	if err != nil {
+		return err
+	}
+	tempAddr, err := NewTemp(resType)
+	if err != nil {
+		return err
+	}
+
+	// Rama falsa
+	EmitAssign(falseAddr, falseType.(string), resType, tempAddr)
+
+	// Completa la asignación de la rama verdadera y el salto al final
+	endJumpRaw, _ := PJumps.Pop()
+	assignQuadRaw, _ := PJumps.Pop()
+	Quads[assignQuadRaw.(int)].Oper = assignOper(trueType.(string), resType)
+	Quads[assignQuadRaw.(int)].Result = tempAddr
+	Quads[endJumpRaw.(int)].Result = len(Quads)
+
+	PilaO.Push(tempAddr)
+	PTypes.Push(resType)
+	return nil
+}
+
+// unifyTypes: Tipo común de dos ramas según el cubo (int y float se vuelven float)
+func unifyTypes(left, right string) (string, error) {
+	if left == right {
+		return left, nil
+	}
+	if res, err := GetResultType(left, right, "+"); err == nil && (res == left || res == right) {
+		return res, nil
+	}
+	return "", fmt.Errorf("error: las ramas de ?: tienen tipos incompatibles %s y %s", left, right)
+}
+
 // -------------------------------------------- PRINT --------------------------------------------
 
 var printArgs []interface{} // Variable temporal para hacerlo a la inversa
+var printEnums []string     // Enum de cada argumento ("_" si no es enum)
 
 // HandlePrintExpression: Expresión dentro de print
 func HandlePrintExpression() error {
 	value, _ := PilaO.Pop()              // Obtiene valor imprimir
+	tipo, _ := PTypes.Pop()              // Su tipo (para imprimir nombres de enum)
 	printArgs = append(printArgs, value) // Agrega a lista temporal
+	printEnums = append(printEnums, enumOrBlank(tipo.(string)))
 	return nil
 }
 
-// HandlePrintString: Maneja string
-func HandlePrintString(strToken interface{}) error {
-	tok := strToken.(*token.Token)
-	str := string(tok.Lit)
-	addr := GetConstAddress(str, "string")
-	printArgs = append(printArgs, addr)
-	return nil
+// enumOrBlank: Nombre del enum para PRINT/PRINTF, o "_" si el tipo no es enum
+func enumOrBlank(tipo string) string {
+	if IsEnum(tipo) {
+		return tipo
+	}
+	return "_"
 }
 
-// FinalizePrint: Genera cuadruplos
-func FinalizePrint() error {
+// FinalizePrint: Genera cuadruplos en una sola línea separados por espacio
+func FinalizePrint(newline bool) error {
+	// Constante con el separador (solo si hay mas de un argumento)
+	var space int
+	if len(printArgs) > 1 {
+		space = GetConstAddress(`" "`, "string")
+	}
+
 	// Genera cuadruplos en orden inverso
 	for i := len(printArgs) - 1; i >= 0; i-- {
-		PushQuad(PRINT, printArgs[i], "_", "_")
+		PushQuad(PRINT, printArgs[i], printEnums[i], "_")
+		if i > 0 {
+			PushQuad(PRINT, space, "_", "_")
+		}
+	}
+
+	// print termina la línea, write no
+	if newline {
+		PushQuad(PRINTLN, "_", "_", "_")
+	}
+	printArgs, printEnums = nil, nil // Limpia las listas temporales
+	return nil
+}
+
+// formatVerb: Verbos de printf permitidos con %[flags][ancho][.precisión]
+var formatVerb = regexp.MustCompile(`%[-+ 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+// HandlePrintf: Revisa el formato contra los tipos y genera PRINT/PRINTF por segmento
+func HandlePrintf(fmtToken interface{}, argCount int) error {
+	tok := fmtToken.(*token.Token)
+	format, err := strconv.Unquote(string(tok.Lit))
+	if err != nil {
+		return errorAt(tok, fmt.Errorf("error: formato de printf inválido %s", tok.Lit))
+	}
+
+	// Saca los argumentos de las pilas (quedan en orden de izquierda a derecha)
+	args := make([]interface{}, argCount)
+	types := make([]string, argCount)
+	for i := argCount - 1; i >= 0; i-- {
+		args[i], _ = PilaO.Pop()
+		tipo, _ := PTypes.Pop()
+		types[i], _ = tipo.(string)
+	}
+
+	// Recorre el formato: el texto se imprime como string, cada verbo consume un argumento
+	next := 0
+	text := ""
+	for _, loc := range formatVerb.FindAllStringIndex(format, -1) {
+		text += format[next:loc[0]]
+		next = loc[1]
+		verb := format[loc[0]:loc[1]]
+
+		if verb == "%%" {
+			text += "%"
+			continue
+		}
+
+		// Los errores del formato apuntan al literal, no al token después de la llamada
+		if len(args) == 0 {
+			return errorAt(tok, fmt.Errorf("error: printf tiene más verbos que argumentos (%s)", verb))
+		}
+		if err := checkFormatVerb(verb, types[0]); err != nil {
+			return errorAt(tok, err)
+		}
+
+		pushPrintText(text)
+		text = ""
+		PushQuadAt(PosOf(tok), PRINTF, args[0], GetConstAddress(strconv.Quote(verb), "string"), enumOrBlank(types[0]))
+		args, types = args[1:], types[1:]
+	}
+	pushPrintText(text + format[next:])
+
+	if len(args) > 0 {
+		return errorAt(tok, fmt.Errorf("error: printf recibió %d argumentos de más para el formato", len(args)))
+	}
+	return nil
+}
+
+// checkFormatVerb: Verifica que el tipo del argumento corresponda al verbo
+func checkFormatVerb(verb, tipo string) error {
+	switch verb[len(verb)-1] {
+	case 'd':
+		if tipo == "int" || tipo == "bigint" {
+			return nil
+		}
+	case 'f':
+		// Como en el cubo, un int se puede usar como float
+		if tipo == "int" || tipo == "float" {
+			return nil
+		}
+	case 's':
+		if tipo == "string" {
+			return nil
+		}
+	case 'v':
+		return nil
+	default:
+		return fmt.Errorf("error: verbo de formato desconocido %s", verb)
+	}
+	return fmt.Errorf("error: verbo %s no acepta un argumento de tipo %s", verb, tipo)
+}
+
+// pushPrintText: Genera PRINT para un texto fijo del formato (si no está vacío)
+func pushPrintText(text string) {
+	if text == "" {
+		return
+	}
+	PushQuad(PRINT, GetConstAddress(strconv.Quote(text), "string"), "_", "_")
+}
+
+// -------------------------------------------- ASSERT --------------------------------------------
+
+// HandleAssert: Genera ASSERT con la condición, el mensaje y la posición del assert
+func HandleAssert(assertToken, msgToken interface{}) error {
+	condAddr, _ := PilaO.Pop()  // Direccion
+	condType, _ := PTypes.Pop() // Tipo
+
+	// La condición del assert debe ser booleana
+	if condType != "bool" {
+		return fmt.Errorf("error: condición de assert debe ser booleana, recibió: %v", condType)
+	}
+
+	// El mensaje es opcional
+	var msgAddr interface{} = "_"
+	if tok, ok := msgToken.(*token.Token); ok {
+		msgAddr = GetConstAddress(string(tok.Lit), "string")
+	}
+
+	PushQuadAt(PosOf(assertToken), ASSERT, condAddr, msgAddr, "_")
+	return nil
+}
+
+// -------------------------------------------- TRY --------------------------------------------
+
+// HandleTryHeader: Inicio del try, genera TRY con el catch pendiente
+func HandleTryHeader() error {
+	PushQuad(TRY, "_", "_", -1)
+	PJumps.Push(len(Quads) - 1) // Guarda posicion para backpatching
+	openTries++
+	return nil
+}
+
+// HandleCatchHeader: Cierra el try y prepara las variables del catch (el mensaje es opcional)
+func HandleCatchHeader(idToken, msgToken interface{}) error {
+	name := string(idToken.(*token.Token).Lit)
+
+	// Si el try termina sin excepción se quita el bloque y se salta el catch
+	PushQuad(ENDTRY, "_", "_", "_")
+	openTries--
+	PushQuad(GOTO, "_", "_", -1)
+	endJump := len(Quads) - 1
+
+	// Completa el TRY para que salte aquí
+	tryJumpRaw, _ := PJumps.Pop()
+	tryJump := tryJumpRaw.(int)
+	Quads[tryJump].Result = len(Quads)
+	PJumps.Push(endJump)
+
+	// La variable del catch guarda el código (int) de la excepción y la segunda su mensaje
+	addr, err := declareCatchVar(name, "int")
+	if err != nil {
+		return err
+	}
+	var msgAddr interface{} = "_"
+	if msgToken != nil {
+		msgName := string(msgToken.(*token.Token).Lit)
+		if msgName == name {
+			return fmt.Errorf("error: el código y el mensaje del catch no pueden llamarse igual ('%s')", name)
+		}
+		if msgAddr, err = declareCatchVar(msgName, "string"); err != nil {
+			return err
+		}
+	}
+	PushQuad(CATCH, msgAddr, "_", addr)
+	return nil
+}
+
+// declareCatchVar: Reutiliza la variable si ya existe en el scope actual, si no la declara
+func declareCatchVar(name, tipo string) (int, error) {
+	if raw, exists := Scopes.Current().Items[name]; exists {
+		vs := raw.(VariableStructure)
+		if vs.Type != tipo {
+			return 0, fmt.Errorf("error: variable del catch '%s' debe ser %s, es %s", name, tipo, vs.Type)
+		}
+		return vs.Address, nil
+	}
+
+	if err := VarDeclaration([]string{name}, tipo); err != nil {
+		return 0, err
+	}
+	raw, _ := Scopes.Current().Get(name)
+	return raw.(VariableStructure).Address, nil
+}
+
+// HandleTry: Completa el salto que brinca el catch
+func HandleTry() error {
+	endJumpRaw, err := PJumps.Pop()
+	if err != nil {
+		return fmt.Errorf("error interno: try sin salto pendiente (%v)", err)
+	}
+	endJump := endJumpRaw.(int)
+	Quads[endJump].Result = len(Quads)
+	return nil
+}
+
+// HandleThrow: Genera THROW con un código int (positivo, los demás son de la VM) o un mensaje string
+func HandleThrow(throwToken interface{}) error {
+	valueAddr, _ := PilaO.Pop()
+	valueType, _ := PTypes.Pop()
+	if valueType != "int" && valueType != "string" {
+		return fmt.Errorf("error: throw espera un código int o un string, recibió: %v", valueType)
 	}
-	printArgs = nil // Limpia la lista temporal
+	PushQuadAt(PosOf(throwToken), THROW, valueAddr, "_", "_")
 	return nil
 }
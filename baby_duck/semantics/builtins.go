@@ -0,0 +1,159 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+)
+
+// -------------------------------------------- BUILTINS --------------------------------------------
+
+// builtinStatements: Funciones predefinidas que se usan como estatuto (append(l, x);)
+var builtinStatements = map[string]func(call BuiltinCall) error{
+	"append":  builtinAppend,
+	"delete":  builtinDelete,
+	"push":    builtinPush,
+	"enqueue": builtinEnqueue,
+	"pop":     discardResult(builtinPop),
+	"dequeue": discardResult(builtinDequeue),
+}
+
+// builtinExpressions: Funciones predefinidas que regresan un valor (len(l))
+var builtinExpressions = map[string]func(call BuiltinCall) error{
+	"len":     builtinLen,
+	"has":     builtinHas,
+	"pop":     builtinPop,
+	"dequeue": builtinDequeue,
+	"peek":    builtinPeek,
+	"isEmpty": builtinIsEmpty,
+	"size":    builtinSize,
+	"substr":  builtinSubstr,
+	"indexOf": builtinIndexOf,
+	"upper":   builtinCase(UPPER),
+	"lower":   builtinCase(LOWER),
+	"toInt":   builtinParse(TOINT, "int"),
+	"toFloat": builtinParse(TOFLOAT, "float"),
+	"str":     builtinStr,
+}
+
+// IsBuiltin: Indica si el nombre es de una función predefinida
+func IsBuiltin(name string) bool {
+	_, isStatement := builtinStatements[name]
+	_, isExpression := builtinExpressions[name]
+	return isStatement || isExpression
+}
+
+// popArgs: Saca n argumentos de las pilas (quedan en orden de izquierda a derecha)
+func popArgs(n int) ([]interface{}, []string) {
+	addrs := make([]interface{}, n)
+	types := make([]string, n)
+	for i := n - 1; i >= 0; i-- {
+		addrs[i], _ = PilaO.Pop()
+		tipo, _ := PTypes.Pop()
+		types[i], _ = tipo.(string)
+	}
+	return addrs, types
+}
+
+// HandleCallExpr: Llamada dentro de una expresión, es una conversión a enum o una función predefinida
+func HandleCallExpr(idToken interface{}, argCount int) error {
+	tok := idToken.(*token.Token)
+	name := string(tok.Lit)
+
+	// Color(n): conversión a enum
+	if IsEnum(name) {
+		if argCount != 1 {
+			return fmt.Errorf("error: la conversión a %s recibe un argumento, recibió %d", name, argCount)
+		}
+		return HandleCast(idToken)
+	}
+
+	addrs, types := popArgs(argCount)
+
+	// range(10): crea un generador
+	if isGenerator(name) {
+		return HandleGeneratorCall(idToken, addrs, types)
+	}
+
+	// div(a, b): función que regresa un valor
+	if returnsValues(name) {
+		return HandleValueCall(idToken, addrs, types)
+	}
+
+	call := BuiltinCall{Name: name, Token: tok, Args: addrs, Types: types}
+	if handler, exists := builtinExpressions[name]; exists {
+		return handler(call)
+	}
+	if _, exists := builtinStatements[name]; exists {
+		return fmt.Errorf("error: '%s' no regresa un valor", name)
+	}
+	return fmt.Errorf("error: '%s' no es un tipo ni una función predefinida", name)
+}
+
+// HandleBuiltinStatement: Función predefinida usada como estatuto
+func HandleBuiltinStatement(idToken interface{}, addrs []interface{}, types []string, argNames []string) error {
+	tok := idToken.(*token.Token)
+	name := string(tok.Lit)
+
+	for _, argName := range argNames {
+		if argName != "" {
+			return fmt.Errorf("error: '%s' no acepta argumentos con nombre", name)
+		}
+	}
+
+	handler, exists := builtinStatements[name]
+	if !exists {
+		return fmt.Errorf("error: el valor de '%s' no se usa", name)
+	}
+	return handler(BuiltinCall{Name: name, Token: tok, Args: addrs, Types: types})
+}
+
+// expectArgs: Verifica el número de argumentos de una función predefinida
+func expectArgs(call BuiltinCall, n int) error {
+	if len(call.Args) != n {
+		return fmt.Errorf("error: '%s' recibe %d argumentos, recibió %d", call.Name, n, len(call.Args))
+	}
+	return nil
+}
+
+// pushResult: Genera un temporal para el resultado de una función predefinida y lo agrega a las pilas
+func pushResult(tipo string) (int, error) {
+	temp, err := NewTemp(tipo)
+	if err != nil {
+		return 0, err
+	}
+	PilaO.Push(temp)
+	PTypes.Push(tipo)
+	return temp, nil
+}
+
+// builtinAppend: append(l, x) agrega x al final de la lista
+func builtinAppend(call BuiltinCall) error {
+	if err := expectArgs(call, 2); err != nil {
+		return err
+	}
+	if !IsListType(call.Types[0]) {
+		return fmt.Errorf("error: append espera una lista, recibió %s", call.Types[0])
+	}
+	elem := ListElemType(call.Types[0])
+	if !CanWiden(call.Types[1], elem) {
+		return fmt.Errorf("error: no se puede agregar %s a %s", call.Types[1], call.Types[0])
+	}
+	PushQuadAt(PosOf(call.Token), LISTAPPEND, call.Args[0], call.Args[1], "_")
+	return nil
+}
+
+// builtinLen: len(l) o len(m) regresa el número de elementos
+func builtinLen(call BuiltinCall) error {
+	if err := expectArgs(call, 1); err != nil {
+		return err
+	}
+	if !IsListType(call.Types[0]) && !IsMapType(call.Types[0]) && call.Types[0] != "string" {
+		return fmt.Errorf("error: len espera una lista, un mapa o un string, recibió %s", call.Types[0])
+	}
+	temp, err := pushResult("int")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), LEN, call.Args[0], "_", temp)
+	return nil
+}
@@ -0,0 +1,147 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------- CONTRACTS --------------------------------------------
+
+var (
+	StripContracts bool   // Compila sin revisar requires/ensures (para versiones finales)
+	SourceText     []byte // Código fuente, para el texto de cada cláusula en los errores
+
+	contractMark int               // Primer cuádruplo de la cláusula que se está compilando
+	requires     [][]QuadStructure // Revisiones pendientes para la entrada de la función
+	ensures      [][]QuadStructure // Revisiones pendientes para antes del ENDFUNC
+	resultNames  []string          // result (o result1..N) visibles mientras se compilan los contratos
+)
+
+// SetSource: Guarda el código fuente para mostrar el texto de los contratos que fallan
+func SetSource(src []byte) {
+	SourceText = src
+}
+
+// BeginContracts: Los cuádruplos de las cláusulas empiezan aquí (justo después del encabezado)
+func BeginContracts() {
+	contractMark = len(Quads)
+	requires, ensures = nil, nil
+}
+
+// BindResults: En los contratos, result (o result1..N si hay varios) es la casilla del valor de retorno;
+// un parámetro con el mismo nombre tiene prioridad
+func BindResults(returns []VariableStructure) {
+	resultNames = nil
+	for i, slot := range returns {
+		name := "result"
+		if len(returns) > 1 {
+			name = fmt.Sprintf("result%d", i+1)
+		}
+		if _, exists := Scopes.Current().Items[name]; exists {
+			continue
+		}
+		Scopes.Current().Put(name, VariableStructure{Name: name, Type: slot.Type, Address: slot.Address})
+		resultNames = append(resultNames, name)
+	}
+}
+
+// EndContracts: Al abrir el cuerpo result deja de existir (el cuerpo puede declarar su propio result)
+func EndContracts(funcInfo interface{}) (interface{}, error) {
+	for _, name := range resultNames {
+		delete(Scopes.Current().Items, name)
+	}
+	resultNames = nil
+	return funcInfo, nil
+}
+
+// usesResult: Indica si los cuádruplos de una cláusula leen alguna casilla de retorno
+func usesResult(chunk []QuadStructure, cond interface{}) (string, bool) {
+	for _, name := range resultNames {
+		raw, _ := Scopes.Current().Get(name)
+		addr := raw.(VariableStructure).Address
+		reads := func(operand interface{}) bool {
+			if ref, ok := operand.(ContainerRef); ok {
+				return ref.Address == addr
+			}
+			return operand == addr
+		}
+		if reads(cond) {
+			return name, true
+		}
+		for _, quad := range chunk {
+			if reads(quad.Left) || reads(quad.Right) {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// HandleContract: requires/ensures <condición>; aparta sus cuádruplos para generarlos en su lugar
+func HandleContract(kindToken, semicolonToken interface{}) error {
+	kindTok := kindToken.(*token.Token)
+	kind := string(kindTok.Lit)
+
+	cond, _ := PilaO.Pop()
+	condType, _ := PTypes.Pop()
+	if condType != "bool" {
+		return fmt.Errorf("error: la condición de %s debe ser booleana, recibió: %v", kind, condType)
+	}
+
+	// Saca los cuádruplos de la condición (se generaron antes del cuerpo)
+	chunk := append([]QuadStructure{}, Quads[contractMark:]...)
+	Quads = Quads[:contractMark]
+	if name, ok := usesResult(chunk, cond); ok && kind == "requires" {
+		return fmt.Errorf("error: requires no puede usar '%s', la función todavía no regresa nada", name)
+	}
+	if StripContracts {
+		return nil
+	}
+
+	text := clauseText(kindTok, semicolonToken.(*token.Token))
+	chunk = append(chunk, QuadStructure{CONTRACT, cond, GetConstAddress(fmt.Sprintf("%q", text), "string"), "_", PosOf(kindToken)})
+	if kind == "requires" {
+		requires = append(requires, chunk)
+	} else {
+		ensures = append(ensures, chunk)
+	}
+	return nil
+}
+
+// EmitRequires: Genera las revisiones de requires al inicio del cuerpo
+func EmitRequires() {
+	for _, chunk := range requires {
+		emitContract(chunk)
+	}
+	requires = nil
+}
+
+// EmitEnsures: Genera las revisiones de ensures antes de terminar la función
+func EmitEnsures() {
+	for _, chunk := range ensures {
+		emitContract(chunk)
+	}
+	ensures = nil
+}
+
+// emitContract: Copia los cuádruplos de una cláusula moviendo los saltos internos (?:)
+func emitContract(chunk []QuadStructure) {
+	offset := len(Quads) - contractMark
+	for _, quad := range chunk {
+		if target, ok := quad.Result.(int); ok && (quad.Oper == GOTO || quad.Oper == GOTOF) &&
+			target >= contractMark && target <= contractMark+len(chunk) {
+			quad.Result = target + offset
+		}
+		Quads = append(Quads, quad)
+	}
+}
+
+// clauseText: Texto de la cláusula tal como se escribió ("requires x > 0")
+func clauseText(kindTok, semicolonTok *token.Token) string {
+	start, end := kindTok.Pos.Offset, semicolonTok.Pos.Offset
+	if SourceText == nil || end > len(SourceText) || start >= end {
+		return fmt.Sprintf("%s (línea %d)", kindTok.Lit, kindTok.Pos.Line)
+	}
+	return strings.Join(strings.Fields(string(SourceText[start:end])), " ")
+}
@@ -0,0 +1,91 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------- GENERATORS --------------------------------------------
+
+var generatorYield string // Tipo que produce el generador que se está compilando ("" fuera de un generador)
+
+// IsGeneratorType: Indica si el tipo es un generador ("generator of int")
+func IsGeneratorType(tipo string) bool {
+	return strings.HasPrefix(tipo, "generator of ")
+}
+
+// GeneratorYieldType: Tipo de los valores que produce un generador
+func GeneratorYieldType(tipo string) string {
+	return strings.TrimPrefix(tipo, "generator of ")
+}
+
+// HandleGeneratorHeader: Encabezado de generator <tipo> nombre(params), se registra como una función
+func HandleGeneratorHeader(typeToken, idToken, paramsToken interface{}) (FuncInfo, error) {
+	tipo := string(typeToken.(*token.Token).Lit)
+	if !IsStorable(tipo) || IsRefType(tipo) || IsEnum(tipo) {
+		return FuncInfo{}, fmt.Errorf("error: un generador produce int, float, bigint o string, no %s", tipo)
+	}
+
+	info, err := HandleFunctionHeader(idToken, paramsToken)
+	if err != nil {
+		return FuncInfo{}, err
+	}
+
+	raw, _ := FunctionDirectory.Get(info.Name)
+	fs := raw.(FunctionStructure)
+	fs.Yields = tipo
+	FunctionDirectory.Put(info.Name, fs)
+	generatorYield = tipo
+	return info, nil
+}
+
+// HandleYield: yield <expresión>; regresa el valor al for-in que recorre el generador
+func HandleYield(yieldToken interface{}) error {
+	value, _ := PilaO.Pop()
+	valueType, _ := PTypes.Pop()
+
+	if generatorYield == "" {
+		return fmt.Errorf("error: yield solo se puede usar dentro de un generador")
+	}
+	if !CanWiden(valueType.(string), generatorYield) {
+		return fmt.Errorf("error: el generador produce %s, yield recibió %v", generatorYield, valueType)
+	}
+	PushQuadAt(PosOf(yieldToken), YIELD, value, "_", "_")
+	return nil
+}
+
+// HandleGeneratorCall: gen(args) dentro de una expresión crea un generador suspendido al inicio de su cuerpo
+func HandleGeneratorCall(idToken interface{}, addrs []interface{}, types []string) error {
+	name := string(idToken.(*token.Token).Lit)
+	fs, binding, err := ResolveOverload(name, types, make([]string, len(types)))
+	if err != nil {
+		return err
+	}
+	if fs.Yields == "" {
+		return fmt.Errorf("error: '%s' no regresa un valor", name)
+	}
+
+	// Los argumentos se pasan igual que en una llamada, GENNEW guarda el registro en el generador
+	PushQuad(ERA, "_", "_", fs.LocalVarCount+fs.TempCount+fs.ParamCount)
+	if err := EmitParameters(fs, binding, addrs); err != nil {
+		return err
+	}
+
+	temp, err := pushResult("generator of " + fs.Yields)
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(idToken), GENNEW, fs.Signature, "_", temp)
+	return nil
+}
+
+// isGenerator: Indica si alguna versión de la función es un generador
+func isGenerator(name string) bool {
+	for _, fs := range Overloads(name) {
+		if fs.Yields != "" {
+			return true
+		}
+	}
+	return false
+}
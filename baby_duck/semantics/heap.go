@@ -0,0 +1,134 @@
+package semantics
+
+// -------------------------------------------- HEAP --------------------------------------------
+
+// minGCThreshold: Objetos vivos mínimos antes de la primera recolección
+const minGCThreshold = 64
+
+// NewHeap: Crea un heap vacío
+func NewHeap() *Heap {
+	return &Heap{
+		Objects:   make(map[HeapRef]interface{}),
+		next:      1, // 0 queda libre para distinguir referencias sin asignar
+		threshold: minGCThreshold,
+	}
+}
+
+// Alloc: Guarda el objeto en el heap y regresa su referencia, recolecta antes si el heap está lleno
+func (vm *VirtualMachine) Alloc(object interface{}) HeapRef {
+	if len(vm.Heap.Objects) >= vm.Heap.threshold {
+		vm.Collect()
+	}
+
+	ref := vm.Heap.next
+	vm.Heap.next++
+	vm.Heap.Objects[ref] = object
+	vm.Heap.allocated++
+	return ref
+}
+
+// Deref: Lee la referencia guardada en la dirección y regresa su objeto
+func (vm *VirtualMachine) Deref(addr int) interface{} {
+	ref, ok := vm.ReadMem(addr).(HeapRef)
+	if !ok {
+		panic("la dirección no guarda una referencia del heap")
+	}
+	object, exists := vm.Heap.Objects[ref]
+	if !exists {
+		panic("referencia a un objeto liberado")
+	}
+	return object
+}
+
+// Resolve: Si el valor es una referencia regresa su objeto (para imprimir), si no el mismo valor
+func (vm *VirtualMachine) Resolve(value interface{}) interface{} {
+	if ref, ok := value.(HeapRef); ok {
+		return vm.Heap.Objects[ref]
+	}
+	return value
+}
+
+// Collect: Mark-and-sweep, las raíces son la memoria global, la local y la de cada llamada pendiente
+func (vm *VirtualMachine) Collect() {
+	marked := make(map[HeapRef]bool)
+	var pending []HeapRef
+
+	// mark: Agrega el valor a pendientes si es una referencia sin marcar
+	mark := func(value interface{}) {
+		if ref, ok := value.(HeapRef); ok && !marked[ref] {
+			marked[ref] = true
+			pending = append(pending, ref)
+		}
+	}
+
+	// Raíces
+	for _, value := range vm.GlobalMemory {
+		mark(value)
+	}
+	for _, value := range vm.LocalMemory {
+		mark(value)
+	}
+	for _, frame := range vm.CallStack {
+		for _, value := range frame.LocalMem {
+			mark(value)
+		}
+	}
+	for _, value := range vm.PendingAR {
+		mark(value)
+	}
+
+	// Marca lo que se alcanza desde los objetos marcados
+	for len(pending) > 0 {
+		ref := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, child := range children(vm.Heap.Objects[ref]) {
+			mark(child)
+		}
+	}
+
+	// Sweep: libera lo que no se marcó
+	for ref := range vm.Heap.Objects {
+		if !marked[ref] {
+			delete(vm.Heap.Objects, ref)
+			vm.Heap.freed++
+		}
+	}
+
+	vm.Heap.collections++
+	vm.Heap.threshold = max(minGCThreshold, 2*len(vm.Heap.Objects))
+}
+
+// children: Valores que guarda un objeto (pueden ser referencias a otros objetos)
+func children(object interface{}) []interface{} {
+	switch obj := object.(type) {
+	case *ListObject:
+		return obj.Items
+	case *StackObject:
+		return obj.Items
+	case *QueueObject:
+		return obj.Items
+	case *GeneratorObject:
+		values := []interface{}{}
+		for _, value := range obj.LocalMem {
+			values = append(values, value)
+		}
+		return values
+	case *MapObject:
+		values := append([]interface{}{}, obj.Keys...)
+		for _, value := range obj.Items {
+			values = append(values, value)
+		}
+		return values
+	}
+	return nil
+}
+
+// HeapStats: Estadísticas actuales del heap
+func (vm *VirtualMachine) HeapStats() HeapStats {
+	return HeapStats{
+		LiveObjects: len(vm.Heap.Objects),
+		Collections: vm.Heap.collections,
+		Allocated:   vm.Heap.allocated,
+		Freed:       vm.Heap.freed,
+	}
+}
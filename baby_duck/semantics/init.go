@@ -0,0 +1,123 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+	"sort"
+)
+
+// -------------------------------------------- INIT --------------------------------------------
+
+var InitStart = -1 // Cuádruplo donde empieza el bloque init (-1 si no hay)
+
+// HandleInitHeader: init { ... } corre una vez antes de main, el GOTO inicial salta aquí
+func HandleInitHeader(initToken interface{}) error {
+	if InitStart >= 0 {
+		return fmt.Errorf("error: el programa ya tiene un bloque init")
+	}
+	InitStart = len(Quads)
+	setupStart = InitStart
+	CurrentFunction = "init"
+
+	// Las listas globales se crean antes de init (y ya no al inicio de main)
+	EmitAllocations(Scopes.global, nil)
+	Scopes.EnterScope()
+	return nil
+}
+
+// HandleInitBlock: Cierra init, al terminar sigue directo con main
+func HandleInitBlock() error {
+	if InitStart < 0 || CurrentFunction != "init" {
+		return fmt.Errorf("error interno: cierre de init sin encabezado")
+	}
+	Scopes.ExitScope()
+	TempVar = 0
+	setupEnd = len(Quads)
+	return nil
+}
+
+// isGlobalValue: Global con valor propio (int, float, string, bigint); las listas y mapas ya están creados
+func isGlobalValue(addr interface{}) bool {
+	a, ok := addr.(int)
+	global := memory.Global
+	return ok && (global.Ints.Contains(a) || global.Floats.Contains(a) || global.BigInts.Contains(a) || global.Strings.Contains(a))
+}
+
+// writesResult: Indica si el Result del cuádruplo es una dirección que se escribe
+func writesResult(oper int) bool {
+	return oper != GOTO && oper != GOTOF && oper != PARAMETER && oper != GOSUB && oper != ERA
+}
+
+// globalReads: Globales que se leen en quads antes de que se les asigne (incluye las funciones llamadas)
+func globalReads(quads []QuadStructure, visited map[int]bool) map[int]bool {
+	written := map[int]bool{}
+	reads := map[int]bool{}
+	read := func(addr interface{}) {
+		if isGlobalValue(addr) && !written[addr.(int)] {
+			reads[addr.(int)] = true
+		}
+	}
+
+	for _, quad := range quads {
+		oper := quad.Oper
+		read(quad.Left)
+		read(quad.Right)
+		if oper == GOSUB {
+			for addr := range functionReads(quad.Result.(int), visited) {
+				read(addr)
+			}
+
+			// Los valores de retorno los escribe la función
+			raw, _ := FunctionDirectory.Get(quad.Left.(string))
+			for _, slot := range raw.(FunctionStructure).Returns {
+				written[slot.Address] = true
+			}
+		}
+		if writesResult(oper) && isGlobalValue(quad.Result) {
+			written[quad.Result.(int)] = true
+		}
+	}
+	return reads
+}
+
+// functionReads: Globales que lee la función que empieza en start (hasta su ENDFUNC)
+func functionReads(start int, visited map[int]bool) map[int]bool {
+	if visited[start] {
+		return nil
+	}
+	visited[start] = true
+
+	end := start
+	for end < len(Quads) && Quads[end].Oper != ENDFUNC {
+		end++
+	}
+	return globalReads(Quads[start:end], visited)
+}
+
+// CheckInitCall: Dentro de init, una llamada no puede leer globales que init todavía no asigna
+func CheckInitCall(idToken interface{}, fs FunctionStructure) error {
+	if InitStart < 0 || CurrentFunction != "init" {
+		return nil
+	}
+
+	// Lo que init ya asignó antes de la llamada
+	assigned := map[int]bool{}
+	for _, quad := range Quads[InitStart:] {
+		if writesResult(quad.Oper) && isGlobalValue(quad.Result) {
+			assigned[quad.Result.(int)] = true
+		}
+	}
+
+	var missing []int
+	for addr := range functionReads(fs.StartQuad, map[int]bool{}) {
+		if !assigned[addr] {
+			missing = append(missing, addr)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Ints(missing)
+	name := string(idToken.(*token.Token).Lit)
+	return fmt.Errorf("error: init llama a '%s', que lee la global '%s' antes de que init le asigne un valor", name, AddressToName[missing[0]])
+}
@@ -0,0 +1,321 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// -------------------------------------------- LISTS --------------------------------------------
+
+// IsListType: Indica si el tipo es una lista ("list of int", "list of float")
+func IsListType(tipo string) bool {
+	return strings.HasPrefix(tipo, "list of ")
+}
+
+// ListElemType: Tipo de los elementos de una lista
+func ListElemType(tipo string) string {
+	return strings.TrimPrefix(tipo, "list of ")
+}
+
+// IsRefType: Indica si el tipo vive en el heap y la variable solo guarda su referencia
+func IsRefType(tipo string) bool {
+	return IsListType(tipo) || IsMapType(tipo) || IsStackType(tipo) || IsQueueType(tipo) || IsGeneratorType(tipo)
+}
+
+// EmitAllocations: Genera NEW para cada variable de referencia del scope (menos los parámetros y generadores)
+func EmitAllocations(scope *Dictionary, params []VariableStructure) {
+	isParam := map[string]bool{}
+	for _, param := range params {
+		isParam[param.Name] = true
+	}
+
+	// Ordena por dirección para que los cuádruplos no dependan del orden del mapa
+	var refs []VariableStructure
+	for _, raw := range scope.Items {
+		vs, ok := raw.(VariableStructure)
+		if ok && IsRefType(vs.Type) && !IsGeneratorType(vs.Type) && !isParam[vs.Name] {
+			refs = append(refs, vs)
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Address < refs[j].Address })
+
+	for _, vs := range refs {
+		PushQuad(NEW, vs.Type, "_", vs.Address)
+	}
+}
+
+// lookupIndexable: Busca una variable de tipo lista o mapa
+func lookupIndexable(idToken interface{}) (VariableStructure, error) {
+	name := string(idToken.(*token.Token).Lit)
+	raw, exists := Scopes.Current().Get(name)
+	if !exists {
+		return VariableStructure{}, fmt.Errorf("error: variable '%s' no declarada", name)
+	}
+	vs := raw.(VariableStructure)
+	if !IsListType(vs.Type) && !IsMapType(vs.Type) {
+		return VariableStructure{}, fmt.Errorf("error: '%s' es %s, no se puede indexar", name, vs.Type)
+	}
+	return vs, nil
+}
+
+// popIndex: Saca el índice (o la clave) de las pilas y verifica su tipo
+func popIndex(vs VariableStructure) (interface{}, error) {
+	index, _ := PilaO.Pop()
+	indexType, _ := PTypes.Pop()
+	if IsMapType(vs.Type) {
+		if indexType != MapKeyType(vs.Type) {
+			return nil, fmt.Errorf("error: la clave de '%s' debe ser %s, recibió %v", vs.Name, MapKeyType(vs.Type), indexType)
+		}
+		return index, nil
+	}
+	if indexType != "int" {
+		return nil, fmt.Errorf("error: el índice de '%s' debe ser int, recibió %v", vs.Name, indexType)
+	}
+	return index, nil
+}
+
+// HandleIndex: Lectura l[i] o m[k]
+func HandleIndex(idToken interface{}) error {
+	vs, err := lookupIndexable(idToken)
+	if err != nil {
+		return err
+	}
+	index, err := popIndex(vs)
+	if err != nil {
+		return err
+	}
+
+	op, elem := LISTGET, ListElemType(vs.Type)
+	if IsMapType(vs.Type) {
+		op, elem = MAPGET, MapValueType(vs.Type)
+	}
+	temp, err := pushResult(elem)
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(idToken), op, ContainerRef{Address: vs.Address, Name: vs.Name}, index, temp)
+	return nil
+}
+
+// HandleIndexAssign: Escritura l[i] = x o m[k] = x
+func HandleIndexAssign(idToken interface{}) error {
+	vs, err := lookupIndexable(idToken)
+	if err != nil {
+		return err
+	}
+
+	value, _ := PilaO.Pop()
+	valueType, _ := PTypes.Pop()
+	index, err := popIndex(vs)
+	if err != nil {
+		return err
+	}
+
+	op, elem := LISTSET, ListElemType(vs.Type)
+	if IsMapType(vs.Type) {
+		op, elem = MAPSET, MapValueType(vs.Type)
+	}
+	if !CanWiden(valueType.(string), elem) {
+		return fmt.Errorf("error: no se puede asignar %v a un elemento de %s", valueType, vs.Type)
+	}
+	PushQuadAt(PosOf(idToken), op, ContainerRef{Address: vs.Address, Name: vs.Name}, index, value)
+	return nil
+}
+
+// IndexTarget: Elemento de una asignación compuesta l[i] op= x (el índice ya está en un temporal)
+type IndexTarget struct {
+	Var   VariableStructure
+	Index int
+	Op    int
+	Token interface{}
+}
+
+// HandleCompoundIndex: Evalúa el índice de l[i] op= x una sola vez y deja el elemento actual en la pila
+func HandleCompoundIndex(idToken interface{}, op int) (IndexTarget, error) {
+	vs, err := lookupIndexable(idToken)
+	if err != nil {
+		return IndexTarget{}, err
+	}
+	index, err := popIndex(vs)
+	if err != nil {
+		return IndexTarget{}, err
+	}
+
+	// Copia el índice: la expresión de la derecha podría cambiar la variable que lo dio
+	indexType := "int"
+	if IsMapType(vs.Type) {
+		indexType = MapKeyType(vs.Type)
+	}
+	indexTemp, err := NewTemp(indexType)
+	if err != nil {
+		return IndexTarget{}, err
+	}
+	PushQuad(ASSIGN, index, "_", indexTemp)
+
+	getOp, elem := LISTGET, ListElemType(vs.Type)
+	if IsMapType(vs.Type) {
+		getOp, elem = MAPGET, MapValueType(vs.Type)
+	}
+	value, err := pushResult(elem)
+	if err != nil {
+		return IndexTarget{}, err
+	}
+	PushQuadAt(PosOf(idToken), getOp, ContainerRef{Address: vs.Address, Name: vs.Name}, indexTemp, value)
+	return IndexTarget{Var: vs, Index: indexTemp, Op: op, Token: idToken}, nil
+}
+
+// HandleCompoundIndexAssign: Opera el elemento leído con la expresión y lo escribe con el mismo índice
+func HandleCompoundIndexAssign(target IndexTarget) error {
+	vs := target.Var
+	PushOpAt(target.Op, target.Token)
+	if err := ProcessOperation([]int{target.Op}, false); err != nil {
+		return err
+	}
+	result, _ := PilaO.Pop()
+	resultType, _ := PTypes.Pop()
+
+	setOp, elem := LISTSET, ListElemType(vs.Type)
+	if IsMapType(vs.Type) {
+		setOp, elem = MAPSET, MapValueType(vs.Type)
+	}
+
+	// Igual que x /= 2 en una variable int: el float se trunca antes de guardarlo
+	if elem == "int" && resultType == "float" {
+		truncated, err := NewTemp("int")
+		if err != nil {
+			return err
+		}
+		PushQuad(TRUNC, result, "_", truncated)
+		result, resultType = truncated, "int"
+	}
+	if !CanWiden(resultType.(string), elem) {
+		return fmt.Errorf("error: no se puede asignar %v a un elemento de %s", resultType, vs.Type)
+	}
+	PushQuadAt(PosOf(target.Token), setOp, ContainerRef{Address: vs.Address, Name: vs.Name}, target.Index, result)
+	return nil
+}
+
+// -------------------------------------------- FOR IN --------------------------------------------
+
+// HandleForHeader: for x in l (elementos), for k in m (claves en orden de inserción) o for x in gen(...)
+func HandleForHeader(idToken interface{}) error {
+	name := string(idToken.(*token.Token).Lit)
+	listAddr, _ := PilaO.Pop()
+	listTypeRaw, _ := PTypes.Pop()
+	listType := listTypeRaw.(string)
+
+	// Cada posición del recorrido da un elemento o una clave
+	var op int
+	var elem string
+	switch {
+	case IsListType(listType):
+		op, elem = LISTGET, ListElemType(listType)
+	case IsMapType(listType):
+		op, elem = MAPKEY, MapKeyType(listType)
+	case IsGeneratorType(listType):
+		return generatorForHeader(idToken, listAddr, listType)
+	default:
+		return fmt.Errorf("error: for-in espera una lista, un mapa o un generador, recibió %s", listType)
+	}
+
+	// La variable del ciclo se declara si no existe en el scope actual
+	loopVar, err := declareLoopVar(name, elem)
+	if err != nil {
+		return err
+	}
+
+	// Copia la referencia (reasignar la lista dentro del ciclo no cambia el recorrido)
+	list, err := NewTemp(listType)
+	if err != nil {
+		return err
+	}
+	index, err := NewTemp("int")
+	if err != nil {
+		return err
+	}
+	PushQuad(ASSIGN, listAddr, "_", list)
+	PushQuad(ASSIGN, GetConstAddress("0", "int"), "_", index)
+
+	// Condición: index < len(list)
+	loopStart := len(Quads)
+	length, err := NewTemp("int")
+	if err != nil {
+		return err
+	}
+	cond, err := NewTemp("bool")
+	if err != nil {
+		return err
+	}
+	PushQuad(LEN, list, "_", length)
+	PushQuad(LESSTHAN, index, length, cond)
+	PushQuad(GOTOF, cond, "_", -1)
+	PushQuad(op, ContainerRef{Address: list}, index, loopVar)
+
+	// Pendientes para el final: regreso, salto de salida e índice
+	PJumps.Push(loopStart)
+	PJumps.Push(len(Quads) - 2)
+	PJumps.Push(index)
+	return nil
+}
+
+// HandleForTail: Avanza el índice (si hay), regresa a la condición y completa la salida
+func HandleForTail() error {
+	indexRaw, _ := PJumps.Pop()
+	falseJumpRaw, _ := PJumps.Pop()
+	loopStartRaw, _ := PJumps.Pop()
+	index := indexRaw.(int)
+
+	if index != -1 {
+		PushQuad(ADD, index, GetConstAddress("1", "int"), index)
+	}
+	PushQuad(GOTO, "_", "_", loopStartRaw.(int))
+	Quads[falseJumpRaw.(int)].Result = len(Quads)
+	return nil
+}
+
+// generatorForHeader: Cada vuelta reanuda el generador, sale cuando termina su cuerpo
+func generatorForHeader(idToken, genAddr interface{}, genType string) error {
+	name := string(idToken.(*token.Token).Lit)
+	loopVar, err := declareLoopVar(name, GeneratorYieldType(genType))
+	if err != nil {
+		return err
+	}
+
+	gen, err := NewTemp(genType)
+	if err != nil {
+		return err
+	}
+	PushQuad(ASSIGN, genAddr, "_", gen)
+
+	loopStart := len(Quads)
+	ok, err := NewTemp("bool")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(idToken), RESUME, gen, loopVar, ok)
+	PushQuad(GOTOF, ok, "_", -1)
+
+	// Sin índice (-1): el generador lleva su propio estado
+	PJumps.Push(loopStart)
+	PJumps.Push(len(Quads) - 1)
+	PJumps.Push(-1)
+	return nil
+}
+
+// declareLoopVar: Usa la variable del ciclo o la declara con el tipo de los elementos
+func declareLoopVar(name, tipo string) (int, error) {
+	if raw, exists := Scopes.Current().Items[name]; exists {
+		vs := raw.(VariableStructure)
+		if vs.Type != tipo {
+			return 0, fmt.Errorf("error: la variable '%s' es %s, el ciclo recorre %s", name, vs.Type, tipo)
+		}
+		return vs.Address, nil
+	}
+	if err := VarDeclaration([]string{name}, tipo); err != nil {
+		return 0, err
+	}
+	raw, _ := Scopes.Current().Get(name)
+	return raw.(VariableStructure).Address, nil
+}
@@ -0,0 +1,72 @@
+package semantics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------- MAPS --------------------------------------------
+
+// IsMapType: Indica si el tipo es un mapa ("map[string]int")
+func IsMapType(tipo string) bool {
+	return strings.HasPrefix(tipo, "map[")
+}
+
+// MapKeyType: Tipo de las claves de un mapa
+func MapKeyType(tipo string) string {
+	key, _, _ := strings.Cut(strings.TrimPrefix(tipo, "map["), "]")
+	return key
+}
+
+// MapValueType: Tipo de los valores de un mapa
+func MapValueType(tipo string) string {
+	_, value, _ := strings.Cut(tipo, "]")
+	return value
+}
+
+// MapTypeName: Arma el nombre del tipo a partir de sus claves y valores
+func MapTypeName(keyType, valueType string) (string, error) {
+	if keyType != "int" && keyType != "string" {
+		return "", fmt.Errorf("error: las claves de un mapa deben ser int o string, no %s", keyType)
+	}
+	if valueType != "int" && valueType != "float" && valueType != "string" {
+		return "", fmt.Errorf("error: los valores de un mapa deben ser int, float o string, no %s", valueType)
+	}
+	return "map[" + keyType + "]" + valueType, nil
+}
+
+// builtinHas: has(m, k) indica si la clave existe
+func builtinHas(call BuiltinCall) error {
+	if err := expectMapKey(call); err != nil {
+		return err
+	}
+	temp, err := pushResult("bool")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), MAPHAS, call.Args[0], call.Args[1], temp)
+	return nil
+}
+
+// builtinDelete: delete(m, k) quita la clave (no hace nada si no existe)
+func builtinDelete(call BuiltinCall) error {
+	if err := expectMapKey(call); err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), MAPDELETE, call.Args[0], call.Args[1], "_")
+	return nil
+}
+
+// expectMapKey: Verifica los argumentos (mapa, clave) de has y delete
+func expectMapKey(call BuiltinCall) error {
+	if err := expectArgs(call, 2); err != nil {
+		return err
+	}
+	if !IsMapType(call.Types[0]) {
+		return fmt.Errorf("error: %s espera un mapa, recibió %s", call.Name, call.Types[0])
+	}
+	if call.Types[1] != MapKeyType(call.Types[0]) {
+		return fmt.Errorf("error: la clave de %s debe ser %s, recibió %s", call.Name, MapKeyType(call.Types[0]), call.Types[1])
+	}
+	return nil
+}
@@ -2,12 +2,15 @@ package semantics
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 // --------------------------------------- DECLARACION ---------------------------------------
 var VarTable = NewDictionary()          // Tabla global de variables
 var FunctionDirectory = NewDictionary() // Directorio de funciones
+var EnumDirectory = NewDictionary()     // Enums declarados
+var TestDirectory = NewDictionary()     // Bloques test (nombre → TestStructure)
 var memory = NewMemoryManager()         // Memoria de direcciones
 var AddressToName = map[int]string{}    // Traducir direcciones a nombre
 
@@ -31,6 +34,52 @@ const (
 	PRINT      = 11015
 	END        = 11016
 	MAIN       = 11017
+	ASSERT     = 11018
+	TRY        = 11019
+	ENDTRY     = 11020
+	CATCH      = 11021
+	THROW      = 11022
+	PRINTLN    = 11023
+	PRINTF     = 11024
+	BITAND     = 11025
+	BITOR      = 11026
+	BITXOR     = 11027
+	SHIFTLEFT  = 11028
+	SHIFTRIGHT = 11029
+	BITNOT     = 11030
+	NEW        = 11031
+	LISTAPPEND = 11032
+	LEN        = 11033
+	LISTGET    = 11034
+	LISTSET    = 11035
+	MAPGET     = 11036
+	MAPSET     = 11037
+	MAPHAS     = 11038
+	MAPDELETE  = 11039
+	MAPKEY     = 11040
+	PUSH       = 11041
+	POP        = 11042
+	PEEK       = 11043
+	ENQUEUE    = 11044
+	DEQUEUE    = 11045
+	ISEMPTY    = 11046
+	GENNEW     = 11047
+	RESUME     = 11048
+	YIELD      = 11049
+	CONTRACT   = 11050
+	TOSTR      = 11051
+	SUBSTR     = 11052
+	INDEXOF    = 11053
+	UPPER      = 11054
+	LOWER      = 11055
+	TOINT      = 11056
+	TOFLOAT    = 11057
+	TRUNC      = 11058
+	NORETURN   = 11059
+	ENUMCHECK  = 11060
+	EQUAL      = 11061
+	LESSEQUAL  = 11062
+	MOREEQUAL  = 11063
 )
 
 // Símbolo
@@ -53,6 +102,52 @@ var FixedAddresses = map[int]string{
 	PRINT:      "PRINT",
 	END:        "END",
 	MAIN:       "MAIN",
+	ASSERT:     "ASSERT",
+	TRY:        "TRY",
+	ENDTRY:     "ENDTRY",
+	CATCH:      "CATCH",
+	THROW:      "THROW",
+	PRINTLN:    "PRINTLN",
+	PRINTF:     "PRINTF",
+	BITAND:     "&",
+	BITOR:      "|",
+	BITXOR:     "xor",
+	SHIFTLEFT:  "<<",
+	SHIFTRIGHT: ">>",
+	BITNOT:     "~",
+	NEW:        "NEW",
+	LISTAPPEND: "LISTAPPEND",
+	LEN:        "LEN",
+	LISTGET:    "LISTGET",
+	LISTSET:    "LISTSET",
+	MAPGET:     "MAPGET",
+	MAPSET:     "MAPSET",
+	MAPHAS:     "MAPHAS",
+	MAPDELETE:  "MAPDELETE",
+	MAPKEY:     "MAPKEY",
+	PUSH:       "PUSH",
+	POP:        "POP",
+	PEEK:       "PEEK",
+	ENQUEUE:    "ENQUEUE",
+	DEQUEUE:    "DEQUEUE",
+	ISEMPTY:    "ISEMPTY",
+	GENNEW:     "GENNEW",
+	RESUME:     "RESUME",
+	YIELD:      "YIELD",
+	CONTRACT:   "CONTRACT",
+	TOSTR:      "TOSTR",
+	SUBSTR:     "SUBSTR",
+	INDEXOF:    "INDEXOF",
+	UPPER:      "UPPER",
+	LOWER:      "LOWER",
+	TOINT:      "TOINT",
+	TOFLOAT:    "TOFLOAT",
+	TRUNC:      "TRUNC",
+	NORETURN:   "NORETURN",
+	ENUMCHECK:  "ENUMCHECK",
+	EQUAL:      "==",
+	LESSEQUAL:  "<=",
+	MOREEQUAL:  ">=",
 }
 
 // --------------------------------------- DICTIONARY ---------------------------------------
@@ -105,6 +200,11 @@ func (m *MemorySegment) GetNext() (int, error) {
 	return addr, nil
 }
 
+// Contains: Indica si la dirección pertenece al segmento
+func (m *MemorySegment) Contains(addr int) bool {
+	return addr >= m.start && addr <= m.end
+}
+
 // Reset: Reinicia el segmento, siguiente espacio disponible es start
 func (m *MemorySegment) Reset() {
 	m.next = m.start
@@ -114,22 +214,32 @@ func (m *MemorySegment) Reset() {
 func NewMemoryManager() *MemoryManager {
 	return &MemoryManager{
 		Global: SegmentGroup{
-			Ints:   NewMemorySegment(1000, 1999),
-			Floats: NewMemorySegment(2000, 2999),
+			Ints:    NewMemorySegment(1000, 1999),
+			Floats:  NewMemorySegment(2000, 2999),
+			BigInts: NewMemorySegment(12000, 12999),
+			Refs:    NewMemorySegment(16000, 16999),
+			Strings: NewMemorySegment(19000, 19999),
 		},
 		Local: SegmentGroup{
-			Ints:   NewMemorySegment(3000, 3999),
-			Floats: NewMemorySegment(4000, 4999),
+			Ints:    NewMemorySegment(3000, 3999),
+			Floats:  NewMemorySegment(4000, 4999),
+			BigInts: NewMemorySegment(13000, 13999),
+			Refs:    NewMemorySegment(17000, 17999),
+			Strings: NewMemorySegment(20000, 20999),
 		},
 		Temp: SegmentGroup{
-			Ints:   NewMemorySegment(5000, 5999),
-			Floats: NewMemorySegment(6000, 6999),
-			Bools:  NewMemorySegment(7000, 7999),
+			Ints:    NewMemorySegment(5000, 5999),
+			Floats:  NewMemorySegment(6000, 6999),
+			Bools:   NewMemorySegment(7000, 7999),
+			BigInts: NewMemorySegment(14000, 14999),
+			Refs:    NewMemorySegment(18000, 18999),
+			Strings: NewMemorySegment(21000, 21999),
 		},
 		Constant: SegmentGroup{
 			Ints:    NewMemorySegment(8000, 8999),
 			Floats:  NewMemorySegment(9000, 9999),
 			Strings: NewMemorySegment(10000, 10999),
+			BigInts: NewMemorySegment(15000, 15999),
 		},
 	}
 }
@@ -145,6 +255,8 @@ func GetConstAddress(literal string, tipo string) int {
 		segment = &memory.Constant.Floats
 	case "string":
 		segment = &memory.Constant.Strings
+	case "bigint":
+		segment = &memory.Constant.BigInts
 	case "bool":
 		segment = &memory.Temp.Bools
 	default:
@@ -171,6 +283,38 @@ func GetConstAddress(literal string, tipo string) int {
 	return addr
 }
 
+// ConstIntValue: Si la dirección es una constante int, regresa su valor
+func ConstIntValue(addr interface{}) (int, bool) {
+	a, ok := addr.(int)
+	if !ok || !memory.Constant.Ints.Contains(a) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(AddressToName[a], "const_"))
+	return n, err == nil
+}
+
+// IsBigIntAddress: Indica si la dirección pertenece a algún segmento de bigint
+func IsBigIntAddress(addr int) bool {
+	return addr >= 12000 && addr <= 15999
+}
+
+// IsRefAddress: Indica si la dirección guarda una referencia al heap
+func IsRefAddress(addr int) bool {
+	return addr >= 16000 && addr <= 18999
+}
+
+// IsStringAddress: Indica si la dirección pertenece a algún segmento de string
+func IsStringAddress(addr int) bool {
+	return (addr >= 10000 && addr <= 10999) || (addr >= 19000 && addr <= 21999)
+}
+
+// IsGlobalAddress: Indica si la dirección vive en memoria global (globales y constantes)
+func IsGlobalAddress(addr int) bool {
+	return (addr >= 1000 && addr <= 2999) || (addr >= 8000 && addr <= 10999) ||
+		(addr >= 12000 && addr <= 12999) || (addr >= 15000 && addr <= 16999) ||
+		(addr >= 19000 && addr <= 19999)
+}
+
 // PrintAddressTable: Imprime tabla de direcciones
 func PrintAddressTable() {
 	fmt.Println("\n==== Tabla de direcciones virtuales ====")
@@ -221,6 +365,16 @@ func ResetMemory() {
 	memory.Constant.Ints.Reset()
 	memory.Constant.Floats.Reset()
 	memory.Constant.Strings.Reset()
+	memory.Global.BigInts.Reset()
+	memory.Local.BigInts.Reset()
+	memory.Temp.BigInts.Reset()
+	memory.Constant.BigInts.Reset()
+	memory.Global.Refs.Reset()
+	memory.Local.Refs.Reset()
+	memory.Temp.Refs.Reset()
+	memory.Global.Strings.Reset()
+	memory.Local.Strings.Reset()
+	memory.Temp.Strings.Reset()
 
 	for k := range AddressToName {
 		delete(AddressToName, k)
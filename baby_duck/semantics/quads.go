@@ -1,19 +1,23 @@
 package semantics
 
 import (
+	"baby_duck/token"
 	"fmt"
-	"strconv"
+	"slices"
 )
 
 // --------------------------------------- DECLARACION ---------------------------------------
 
 var (
-	PilaO   = NewStack()    // Operandos (variables, constantes, temporales)
-	PTypes  = NewStack()    // Tipos de operadores (int, float, bool)
-	POper   = NewStack()    // Operadores (+, *, <...)
-	Quads   []QuadStructure // Cuádruplos generados (+, x, 5, t1)
-	TempVar int             // Contador para nombres de variables temporales
-	PJumps  = NewStack()    // Stack para saltos pendientes
+	PilaO    = NewStack()    // Operandos (variables, constantes, temporales)
+	PTypes   = NewStack()    // Tipos de operadores (int, float, bool)
+	POper    = NewStack()    // Operadores (+, *, <...)
+	POperPos = NewStack()    // Posición de cada operador en POper
+	Quads    []QuadStructure // Cuádruplos generados (+, x, 5, t1)
+	TempVar  int             // Contador para nombres de variables temporales
+	PJumps   = NewStack()    // Stack para saltos pendientes
+
+	CurrentFunction string // Función que se está compilando (para posiciones)
 )
 
 // ------------------------------------------ STACK ------------------------------------------
@@ -58,23 +62,14 @@ func (s *Stack) Peek() (interface{}, error) {
 func PushOperandDebug(value interface{}, tipo string) {
 	var address int // Declara para asignar despues
 
-	// Si es constante
-	if tipo == "int" || tipo == "float" || tipo == "bool" || tipo == "string" {
-		constID := fmt.Sprintf("%v", value)
-		// Si ya tiene direccion asignada usarla
-		if num, err := strconv.Atoi(constID); err == nil && num >= 1000 && num <= 6999 {
-			address = num
-		} else {
-			// Sino, obtener direccion
-			address = GetConstAddress(constID, tipo)
-		}
-
-		// Registra como constante si ya no hay direcciones disponibles
-		if address < 1000 || address >= 7000 {
-			AddressToName[address] = fmt.Sprintf("const_%s", constID)
-		}
+	if addr, ok := value.(int); ok {
+		// Si es una variable ya trae su dirección
+		address = addr
+	} else if tipo == "int" || tipo == "float" || tipo == "bool" || tipo == "string" || tipo == "bigint" {
+		// Si es constante, busca u obtiene su dirección (el literal de un bigint viene sin la n)
+		address = GetConstAddress(fmt.Sprintf("%v", value), tipo)
 	} else {
-		// Si es una variable, buscamos su dirección
+		// Si es el nombre de una variable, buscamos su dirección
 		raw, _ := Scopes.Current().Get(fmt.Sprintf("%v", value))
 		v := raw.(VariableStructure)
 		address = v.Address
@@ -87,12 +82,33 @@ func PushOperandDebug(value interface{}, tipo string) {
 // PushOp: En lugar de haer push directo lo hace desde acá para debuggear
 func PushOp(op int) {
 	//fmt.Printf("→ PUSH OPERADOR: %s\n", op)
+	PushOpAt(op, nil)
+}
+
+// PushOpAt: Agrega un operador junto con la posición de su token (para errores de ejecución)
+func PushOpAt(op int, tok interface{}) {
 	POper.Push(op)
+	POperPos.Push(PosOf(tok))
 }
 
 // PushQuad: Agrega un cuádruplo a la lista global
 func PushQuad(oper int, left, right, res interface{}) {
-	Quads = append(Quads, QuadStructure{oper, left, right, res})
+	PushQuadAt(SourcePos{Function: CurrentFunction}, oper, left, right, res)
+}
+
+// PushQuadAt: Agrega un cuádruplo guardando su posición en el código fuente
+func PushQuadAt(pos SourcePos, oper int, left, right, res interface{}) {
+	Quads = append(Quads, QuadStructure{oper, left, right, res, pos})
+}
+
+// PosOf: Obtiene la posición de un token dentro de la función actual
+func PosOf(tok interface{}) SourcePos {
+	pos := SourcePos{Function: CurrentFunction}
+	if t, ok := tok.(*token.Token); ok {
+		pos.Line = t.Pos.Line
+		pos.Column = t.Pos.Column
+	}
+	return pos
 }
 
 // ProcessOperation: Agregar quads
@@ -108,6 +124,7 @@ func ProcessOperation(validOps []int, stopOnFakeBottom bool) error {
 		// Caso especial: fake bottom
 		if stopOnFakeBottom && op == FAKEBOTTOM {
 			POper.Pop()
+			POperPos.Pop()
 			break
 		}
 
@@ -128,6 +145,8 @@ func ProcessOperation(validOps []int, stopOnFakeBottom bool) error {
 
 		// Lógica de procesamiento
 		POper.Pop()
+		rawPos, _ := POperPos.Pop()
+		pos, _ := rawPos.(SourcePos)
 		rightOp, _ := PilaO.Pop()
 		rightType, _ := PTypes.Pop()
 		leftOp, _ := PilaO.Pop()
@@ -146,29 +165,50 @@ func ProcessOperation(validOps []int, stopOnFakeBottom bool) error {
 		}
 
 		// Asignar direccion memoria temporal
-		var tempAddr int
-		switch resType {
-		case "int":
-			tempAddr, _ = memory.Temp.Ints.GetNext()
-			TempVar++
-		case "float":
-			tempAddr, _ = memory.Temp.Floats.GetNext()
-			TempVar++
-		case "bool":
-			tempAddr, _ = memory.Temp.Bools.GetNext()
-			TempVar++
+		tempAddr, err := NewTemp(resType)
+		if err != nil {
+			return err
 		}
 
-		AddressToName[tempAddr] = fmt.Sprintf("temp_%d", tempAddr)
-
-		// Hace el quad
-		PushQuad(op, leftOp, rightOp, tempAddr)
+		// Hace el quad con la posición del operador
+		PushQuadAt(pos, op, leftOp, rightOp, tempAddr)
 		PilaO.Push(tempAddr)
 		PTypes.Push(resType)
 	}
 	return nil
 }
 
+// NewTemp: Asigna la siguiente dirección temporal del tipo indicado
+func NewTemp(tipo string) (int, error) {
+	var tempAddr int
+	var err error
+	switch tipo {
+	case "int":
+		tempAddr, err = memory.Temp.Ints.GetNext()
+	case "float":
+		tempAddr, err = memory.Temp.Floats.GetNext()
+	case "bool":
+		tempAddr, err = memory.Temp.Bools.GetNext()
+	case "bigint":
+		tempAddr, err = memory.Temp.BigInts.GetNext()
+	case "string":
+		tempAddr, err = memory.Temp.Strings.GetNext()
+	default:
+		// Listas (y otros objetos del heap) guardan una referencia
+		if !IsRefType(tipo) {
+			return 0, fmt.Errorf("tipo no soportado para temporal: %s", tipo)
+		}
+		tempAddr, err = memory.Temp.Refs.GetNext()
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	TempVar++
+	AddressToName[tempAddr] = fmt.Sprintf("temp_%d", tempAddr)
+	return tempAddr, nil
+}
+
 // DoAddSub: Agregar quad para suma o resta
 func DoAddSub() error {
 	return ProcessOperation([]int{ADD, REST}, false)
@@ -181,10 +221,31 @@ func DoMulDiv() error {
 
 // DoRelational: Agregar quad para operadores relacionales
 func DoRelational() error {
-	if top, err := POper.Peek(); err != nil || !(top.(int) == LESSTHAN || top.(int) == MORETHAN || top.(int) == NOTEQUAL) {
+	relational := []int{LESSTHAN, MORETHAN, NOTEQUAL, EQUAL, LESSEQUAL, MOREEQUAL}
+	if top, err := POper.Peek(); err != nil || !slices.Contains(relational, top.(int)) {
 		return nil
 	}
-	return ProcessOperation([]int{LESSTHAN, MORETHAN, NOTEQUAL}, false)
+	return ProcessOperation(relational, false)
+}
+
+// DoShift: Agregar quad para corrimientos de bits
+func DoShift() error {
+	return ProcessOperation([]int{SHIFTLEFT, SHIFTRIGHT}, false)
+}
+
+// DoBitAnd: Agregar quad para and de bits
+func DoBitAnd() error {
+	return ProcessOperation([]int{BITAND}, false)
+}
+
+// DoBitXor: Agregar quad para xor de bits
+func DoBitXor() error {
+	return ProcessOperation([]int{BITXOR}, false)
+}
+
+// DoBitOr: Agregar quad para or de bits
+func DoBitOr() error {
+	return ProcessOperation([]int{BITOR}, false)
 }
 
 // PopUntilFakeBottom: Agregar simbolo para parentesis
@@ -221,6 +282,8 @@ func ResetStacks() {
 	PilaO = NewStack()
 	PTypes = NewStack()
 	POper = NewStack()
+	POperPos = NewStack()
 	Quads = []QuadStructure{}
 	TempVar = 0
+	CurrentFunction = ""
 }
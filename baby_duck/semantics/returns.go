@@ -0,0 +1,184 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"fmt"
+)
+
+// -------------------------------------------- RETURNS --------------------------------------------
+
+var returnFunction string // Firma de la función que se está compilando ("" fuera de una función)
+var pendingReturns []int  // GOTO de cada return, se completan al cerrar la función
+var openTries int         // try abiertos donde se está compilando (un return los cierra antes de salir)
+
+// BeginReturns: Empieza a juntar los return de la función
+func BeginReturns(signature string) {
+	returnFunction = signature
+	pendingReturns = nil
+}
+
+// PatchReturns: Cada return salta al cierre de la función (antes de los ensures); si la función
+// regresa valores, llegar al final sin return es error
+func PatchReturns(fs FunctionStructure, endToken interface{}) error {
+	if len(fs.Returns) > 0 {
+		if len(pendingReturns) == 0 {
+			return fmt.Errorf("error: '%s' regresa %d valores pero no tiene return", fs.Name, len(fs.Returns))
+		}
+		PushQuadAt(PosOf(endToken), NORETURN, fs.Name, "_", "_")
+	}
+	for _, quad := range pendingReturns {
+		Quads[quad].Result = len(Quads)
+	}
+	returnFunction = ""
+	pendingReturns = nil
+	return nil
+}
+
+// HandleReturningHeader: func nombre(params): (tipos) se registra como una función con casillas de retorno
+func HandleReturningHeader(idToken, paramsToken, typesToken interface{}) (FuncInfo, error) {
+	types := typesToken.([]string)
+	for _, tipo := range types {
+		if !IsStorable(tipo) || IsGeneratorType(tipo) {
+			return FuncInfo{}, fmt.Errorf("error: una función no puede regresar %s", tipo)
+		}
+	}
+
+	info, err := HandleFunctionHeader(idToken, paramsToken)
+	if err != nil {
+		return FuncInfo{}, err
+	}
+
+	// Cada valor regresa en una global propia, el que llama la copia después del GOSUB
+	raw, _ := FunctionDirectory.Get(info.Name)
+	fs := raw.(FunctionStructure)
+	for i, tipo := range types {
+		addr, err := returnSlot(tipo)
+		if err != nil {
+			return FuncInfo{}, err
+		}
+		AddressToName[addr] = fmt.Sprintf("%s_ret_%d", fs.Name, i+1)
+		fs.Returns = append(fs.Returns, VariableStructure{Name: AddressToName[addr], Type: tipo, Address: addr})
+	}
+	FunctionDirectory.Put(info.Name, fs)
+	BindResults(fs.Returns)
+	return info, nil
+}
+
+// returnSlot: Dirección global para un valor de retorno
+func returnSlot(tipo string) (int, error) {
+	switch {
+	case tipo == "int" || IsEnum(tipo):
+		return memory.Global.Ints.GetNext()
+	case tipo == "float":
+		return memory.Global.Floats.GetNext()
+	case tipo == "bigint":
+		return memory.Global.BigInts.GetNext()
+	case tipo == "string":
+		return memory.Global.Strings.GetNext()
+	default:
+		return memory.Global.Refs.GetNext()
+	}
+}
+
+// HandleReturn: return a, b; copia los valores a las casillas y salta al final de la función
+func HandleReturn(returnToken interface{}, count int) error {
+	addrs, types := popArgs(count)
+	if returnFunction == "" {
+		return fmt.Errorf("error: return solo se puede usar dentro de una función")
+	}
+
+	raw, _ := FunctionDirectory.Get(returnFunction)
+	fs := raw.(FunctionStructure)
+	if count != len(fs.Returns) {
+		return fmt.Errorf("error: '%s' regresa %d valores, return tiene %d", fs.Name, len(fs.Returns), count)
+	}
+	for i, slot := range fs.Returns {
+		if err := CheckAssignType(slot.Type, types[i]); err != nil {
+			return fmt.Errorf("%v en el valor %d de return", err, i+1)
+		}
+		EmitAssign(addrs[i], types[i], slot.Type, slot.Address)
+	}
+
+	// Un return dentro de try quita sus bloques, si no su catch atraparía errores de quien llamó
+	for i := 0; i < openTries; i++ {
+		PushQuad(ENDTRY, "_", "_", "_")
+	}
+	PushQuadAt(PosOf(returnToken), GOTO, "_", "_", -1)
+	pendingReturns = append(pendingReturns, len(Quads)-1)
+	return nil
+}
+
+// returnsValues: Indica si alguna versión de la función regresa valores
+func returnsValues(name string) bool {
+	for _, fs := range Overloads(name) {
+		if len(fs.Returns) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleValueCall: f(args) dentro de una expresión, la función debe regresar un solo valor
+func HandleValueCall(idToken interface{}, addrs []interface{}, types []string) error {
+	name := string(idToken.(*token.Token).Lit)
+	fs, binding, err := ResolveOverload(name, types, make([]string, len(types)))
+	if err != nil {
+		return err
+	}
+	if len(fs.Returns) != 1 {
+		return fmt.Errorf("error: '%s' regresa %d valores, se usa como a, b = %s(...);", name, len(fs.Returns), name)
+	}
+	if err := CheckInitCall(idToken, fs); err != nil {
+		return err
+	}
+
+	PushQuad(ERA, "_", "_", fs.LocalVarCount+fs.TempCount+fs.ParamCount)
+	if err := EmitParameters(fs, binding, addrs); err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(idToken), GOSUB, fs.Signature, "_", fs.StartQuad)
+
+	// Copia el valor a un temporal antes de que otra llamada use la casilla
+	temp, err := pushResult(fs.Returns[0].Type)
+	if err != nil {
+		return err
+	}
+	PushQuad(ASSIGN, fs.Returns[0].Address, "_", temp)
+	return nil
+}
+
+// HandleMultiAssign: q, r = f(args); copia cada valor de retorno a su variable
+func HandleMultiAssign(idToken, idsToken, eraToken interface{}, argNames []string) error {
+	names := append([]string{string(idToken.(*token.Token).Lit)}, idsToken.([]string)...)
+	fnName := string(eraToken.(*token.Token).Lit)
+	if IsBuiltin(fnName) {
+		return fmt.Errorf("error: '%s' no regresa varios valores", fnName)
+	}
+
+	fs, err := emitCall(eraToken, argNames)
+	if err != nil {
+		return err
+	}
+	if len(names) != len(fs.Returns) {
+		return fmt.Errorf("error: '%s' regresa %d valores, se asignan a %d variables", fnName, len(fs.Returns), len(names))
+	}
+
+	seen := map[string]bool{}
+	for i, name := range names {
+		raw, exists := Scopes.Current().Get(name)
+		if !exists {
+			return fmt.Errorf("error: variable '%s' no declarada", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("error: '%s' aparece dos veces en la asignación", name)
+		}
+		seen[name] = true
+
+		vs := raw.(VariableStructure)
+		if err := CheckAssignType(vs.Type, fs.Returns[i].Type); err != nil {
+			return fmt.Errorf("%v en la asignación a '%s'", err, name)
+		}
+		EmitAssign(fs.Returns[i].Address, fs.Returns[i].Type, vs.Type, vs.Address)
+	}
+	return nil
+}
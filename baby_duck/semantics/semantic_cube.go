@@ -6,14 +6,22 @@ import "fmt"
 var cube = SemanticCube{
 	"int": {
 		"int": {
-			"+":  "int",
-			"-":  "int",
-			"*":  "int",
-			"/":  "float",
-			"=":  "int",
-			"!=": "bool",
-			"<":  "bool",
-			">":  "bool",
+			"+":   "int",
+			"-":   "int",
+			"*":   "int",
+			"/":   "float",
+			"=":   "int",
+			"!=":  "bool",
+			"==":  "bool",
+			"<":   "bool",
+			"<=":  "bool",
+			">":   "bool",
+			">=":  "bool",
+			"&":   "int",
+			"|":   "int",
+			"xor": "int",
+			"<<":  "int",
+			">>":  "int",
 		},
 		"float": {
 			"+":  "float",
@@ -22,8 +30,23 @@ var cube = SemanticCube{
 			"/":  "float",
 			"=":  "int",
 			"!=": "bool",
+			"==": "bool",
 			"<":  "bool",
+			"<=": "bool",
 			">":  "bool",
+			">=": "bool",
+		},
+		"bigint": {
+			"+":  "bigint",
+			"-":  "bigint",
+			"*":  "bigint",
+			"/":  "bigint",
+			"!=": "bool",
+			"==": "bool",
+			"<":  "bool",
+			"<=": "bool",
+			">":  "bool",
+			">=": "bool",
 		},
 	},
 	"float": {
@@ -34,8 +57,11 @@ var cube = SemanticCube{
 			"/":  "float",
 			"=":  "int",
 			"!=": "bool",
+			"==": "bool",
 			"<":  "bool",
+			"<=": "bool",
 			">":  "bool",
+			">=": "bool",
 		},
 		"float": {
 			"+":  "float",
@@ -44,19 +70,78 @@ var cube = SemanticCube{
 			"/":  "float",
 			"=":  "int",
 			"!=": "bool",
+			"==": "bool",
 			"<":  "bool",
+			"<=": "bool",
 			">":  "bool",
+			">=": "bool",
+		},
+	},
+	// bigint opera con int y bigint (la división trunca), nunca con float
+	"bigint": {
+		"int": {
+			"+":  "bigint",
+			"-":  "bigint",
+			"*":  "bigint",
+			"/":  "bigint",
+			"!=": "bool",
+			"==": "bool",
+			"<":  "bool",
+			"<=": "bool",
+			">":  "bool",
+			">=": "bool",
+		},
+		"bigint": {
+			"+":  "bigint",
+			"-":  "bigint",
+			"*":  "bigint",
+			"/":  "bigint",
+			"!=": "bool",
+			"==": "bool",
+			"<":  "bool",
+			"<=": "bool",
+			">":  "bool",
+			">=": "bool",
 		},
 	},
 	"bool": {
 		"bool": {
 			"!=": "bool",
+			"==": "bool",
+		},
+	},
+	// + concatena; las comparaciones son en orden lexicográfico
+	"string": {
+		"string": {
+			"+":  "string",
+			"!=": "bool",
+			"==": "bool",
+			"<":  "bool",
+			"<=": "bool",
+			">":  "bool",
+			">=": "bool",
 		},
 	},
 }
 
+// bitwiseOperators: Operadores que solo aceptan int
+var bitwiseOperators = map[string]bool{"&": true, "|": true, "xor": true, "<<": true, ">>": true}
+
 // GetResultType: Devuelve el tipo del resultado según el cubo
 func GetResultType(leftType, rightType, operator string) (string, error) {
+	// Un enum solo se compara con valores del mismo enum
+	if IsEnum(leftType) || IsEnum(rightType) {
+		if leftType == rightType && cube["int"]["int"][operator] == "bool" {
+			return "bool", nil
+		}
+		return "", fmt.Errorf("semántico: combinación no definida %s %s %s (usa una conversión)", leftType, operator, rightType)
+	}
+
+	// Los operadores de bits solo existen para int
+	if bitwiseOperators[operator] && (leftType != "int" || rightType != "int") {
+		return "", fmt.Errorf("semántico: el operador %s solo acepta int, recibió %s y %s", operator, leftType, rightType)
+	}
+
 	// Busca directamente en el cubo semántico
 	if res, ok := cube[leftType][rightType][operator]; ok {
 
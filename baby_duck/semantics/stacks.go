@@ -0,0 +1,130 @@
+package semantics
+
+import (
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------- STACKS & QUEUES --------------------------------------------
+
+// IsStackType: Indica si el tipo es una pila ("stack of int")
+func IsStackType(tipo string) bool {
+	return strings.HasPrefix(tipo, "stack of ")
+}
+
+// IsQueueType: Indica si el tipo es una cola ("queue of float")
+func IsQueueType(tipo string) bool {
+	return strings.HasPrefix(tipo, "queue of ")
+}
+
+// StackElemType: Tipo de los elementos de una pila o cola
+func StackElemType(tipo string) string {
+	_, elem, _ := strings.Cut(tipo, " of ")
+	return elem
+}
+
+// builtinPush: push(s, x) agrega x arriba de la pila
+func builtinPush(call BuiltinCall) error {
+	return pushElement(call, IsStackType, "una pila", PUSH)
+}
+
+// builtinEnqueue: enqueue(q, x) agrega x al final de la cola
+func builtinEnqueue(call BuiltinCall) error {
+	return pushElement(call, IsQueueType, "una cola", ENQUEUE)
+}
+
+// pushElement: Verifica el contenedor y el tipo del elemento de push/enqueue
+func pushElement(call BuiltinCall, isType func(string) bool, expected string, op int) error {
+	if err := expectArgs(call, 2); err != nil {
+		return err
+	}
+	if !isType(call.Types[0]) {
+		return fmt.Errorf("error: %s espera %s, recibió %s", call.Name, expected, call.Types[0])
+	}
+	if !CanWiden(call.Types[1], StackElemType(call.Types[0])) {
+		return fmt.Errorf("error: no se puede agregar %s a %s", call.Types[1], call.Types[0])
+	}
+	PushQuadAt(PosOf(call.Token), op, call.Args[0], call.Args[1], "_")
+	return nil
+}
+
+// builtinPop: pop(s) quita y regresa el elemento de arriba
+func builtinPop(call BuiltinCall) error {
+	return takeElement(call, IsStackType, "una pila", POP)
+}
+
+// builtinDequeue: dequeue(q) quita y regresa el primer elemento
+func builtinDequeue(call BuiltinCall) error {
+	return takeElement(call, IsQueueType, "una cola", DEQUEUE)
+}
+
+// builtinPeek: peek(s) o peek(q) regresa el siguiente elemento sin quitarlo
+func builtinPeek(call BuiltinCall) error {
+	isEither := func(tipo string) bool { return IsStackType(tipo) || IsQueueType(tipo) }
+	return takeElement(call, isEither, "una pila o una cola", PEEK)
+}
+
+// takeElement: Genera pop/dequeue/peek con un temporal del tipo de los elementos
+func takeElement(call BuiltinCall, isType func(string) bool, expected string, op int) error {
+	if err := expectArgs(call, 1); err != nil {
+		return err
+	}
+	if !isType(call.Types[0]) {
+		return fmt.Errorf("error: %s espera %s, recibió %s", call.Name, expected, call.Types[0])
+	}
+	temp, err := pushResult(StackElemType(call.Types[0]))
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), op, call.Args[0], "_", temp)
+	return nil
+}
+
+// discardResult: Usa una función que regresa valor como estatuto (pop(s);) e ignora el resultado
+func discardResult(handler func(BuiltinCall) error) func(BuiltinCall) error {
+	return func(call BuiltinCall) error {
+		if err := handler(call); err != nil {
+			return err
+		}
+		PilaO.Pop()
+		PTypes.Pop()
+		return nil
+	}
+}
+
+// builtinIsEmpty: isEmpty(s) indica si la pila o cola no tiene elementos
+func builtinIsEmpty(call BuiltinCall) error {
+	if err := expectStackOrQueue(call); err != nil {
+		return err
+	}
+	temp, err := pushResult("bool")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), ISEMPTY, call.Args[0], "_", temp)
+	return nil
+}
+
+// builtinSize: size(s) regresa el número de elementos de la pila o cola
+func builtinSize(call BuiltinCall) error {
+	if err := expectStackOrQueue(call); err != nil {
+		return err
+	}
+	temp, err := pushResult("int")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), LEN, call.Args[0], "_", temp)
+	return nil
+}
+
+// expectStackOrQueue: Verifica el único argumento de isEmpty y size
+func expectStackOrQueue(call BuiltinCall) error {
+	if err := expectArgs(call, 1); err != nil {
+		return err
+	}
+	if !IsStackType(call.Types[0]) && !IsQueueType(call.Types[0]) {
+		return fmt.Errorf("error: %s espera una pila o una cola, recibió %s", call.Name, call.Types[0])
+	}
+	return nil
+}
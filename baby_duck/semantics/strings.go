@@ -0,0 +1,264 @@
+package semantics
+
+import (
+	parseError "baby_duck/errors"
+	"baby_duck/lexer"
+	"baby_duck/token"
+	"fmt"
+	"strings"
+)
+
+// -------------------------------------------- STRINGS --------------------------------------------
+
+// Scanner: Fuente de tokens para el parser
+type Scanner interface {
+	Scan() *token.Token
+}
+
+// interpolating: {expresiones} que se están compilando, fuera de ellas interp_start no es válido
+var interpolating int
+
+// ParseExpression: Compila una expresión suelta sobre las mismas pilas (lo asigna el parser, semantics no lo puede importar)
+var ParseExpression func(scanner Scanner) error
+
+// exprScanner: Tokens de una expresión dentro de un string, con posiciones dentro del literal
+type exprScanner struct {
+	lexer   *lexer.Lexer
+	started bool
+	base    token.Pos // Posición del primer carácter de la expresión
+}
+
+// Scan: Primero el token que escoge la expresión como inicio, después los de la expresión
+func (s *exprScanner) Scan() *token.Token {
+	if !s.started {
+		s.started = true
+		return &token.Token{Type: token.TokMap.Type("interp_start"), Pos: s.base}
+	}
+
+	tok := s.lexer.Scan()
+	if tok.Pos.Line == 1 {
+		tok.Pos.Column += s.base.Column - 1
+	}
+	tok.Pos.Line += s.base.Line - 1
+	tok.Pos.Offset += s.base.Offset
+	return tok
+}
+
+// HandleInterpStart: El inicio de una {expresión} solo lo puede mandar exprScanner
+func HandleInterpStart(startToken interface{}) error {
+	if interpolating == 0 {
+		return errorAt(startToken.(*token.Token), fmt.Errorf("error: carácter \\x01 inválido en el código"))
+	}
+	return nil
+}
+
+// HandleString: Constante string; cada {expresión} se compila, se convierte a texto y se concatena.
+// Una llave literal se escribe doble ({{ y }}); el formato de printf no se interpola
+func HandleString(strToken interface{}) error {
+	tok := strToken.(*token.Token)
+	lit := string(tok.Lit)
+	if !strings.Contains(lit, "{") && !strings.Contains(lit, "}") {
+		PushOperandDebug(lit, "string")
+		return nil
+	}
+
+	var parts []int
+	body := lit[1 : len(lit)-1]
+	text := ""
+	for i := 0; i < len(body); i++ {
+		switch {
+		case strings.HasPrefix(body[i:], "{{") || strings.HasPrefix(body[i:], "}}"):
+			// {{ y }} escriben la llave
+			text += body[i : i+1]
+			i++
+		case body[i] == '}':
+			return fmt.Errorf("error: '}' sin '{' en el string (usa }} para escribirla)")
+		case body[i] == '{':
+			end := strings.IndexByte(body[i:], '}')
+			if end == -1 {
+				return fmt.Errorf("error: '{' sin cerrar en el string (usa {{ para escribirla)")
+			}
+			if text != "" {
+				parts = append(parts, GetConstAddress(`"`+text+`"`, "string"))
+				text = ""
+			}
+
+			// Posición del primer carácter después de '{' (el literal empieza con comillas)
+			base := tok.Pos
+			base.Offset += i + 2
+			base.Column += i + 2
+			addr, err := interpolate(body[i+1:i+end], base)
+			if err != nil {
+				return err
+			}
+			parts = append(parts, addr)
+			i += end
+		default:
+			text += body[i : i+1]
+		}
+	}
+	if text != "" || len(parts) == 0 {
+		parts = append(parts, GetConstAddress(`"`+text+`"`, "string"))
+	}
+
+	// Concatena de izquierda a derecha
+	result := parts[0]
+	for _, part := range parts[1:] {
+		temp, err := NewTemp("string")
+		if err != nil {
+			return err
+		}
+		PushQuadAt(PosOf(tok), ADD, result, part, temp)
+		result = temp
+	}
+	PilaO.Push(result)
+	PTypes.Push("string")
+	return nil
+}
+
+// interpolate: Compila la expresión de un {…} y genera su conversión a texto
+func interpolate(src string, base token.Pos) (int, error) {
+	if strings.TrimSpace(src) == "" {
+		return 0, fmt.Errorf("%d:%d: error: {} sin expresión en el string", base.Line, base.Column)
+	}
+
+	// Los operadores pendientes de la expresión de afuera no se mezclan con los de adentro
+	PushOp(FAKEBOTTOM)
+	interpolating++
+	err := ParseExpression(&exprScanner{lexer: lexer.NewLexer([]byte(src)), base: base})
+	interpolating--
+	if err != nil {
+		// El error ya trae su posición dentro del literal (ver SourceError)
+		return 0, err
+	}
+	POper.Pop()
+	POperPos.Pop()
+
+	value, _ := PilaO.Pop()
+	tipo, _ := PTypes.Pop()
+	return toText(value, tipo.(string), base)
+}
+
+// SourceError: Regresa el error con la posición de su causa: la de una {expresión} dentro de un string
+// o la del token de un PosError, en lugar de la del token que sigue a la regla
+func SourceError(err error) error {
+	for {
+		e, ok := err.(*parseError.Error)
+		if !ok {
+			return err
+		}
+		switch inner := e.Err.(type) {
+		case *parseError.Error:
+			err = inner
+		case *PosError:
+			return &parseError.Error{Err: inner.Err, ErrorToken: &token.Token{Pos: inner.Pos}}
+		default:
+			return err
+		}
+	}
+}
+
+// errorAt: Error con la posición del token (ver SourceError)
+func errorAt(tok *token.Token, err error) error {
+	return &PosError{Pos: tok.Pos, Err: err}
+}
+
+// toText: Convierte un valor a string (un enum da el nombre del miembro)
+func toText(value interface{}, tipo string, pos token.Pos) (int, error) {
+	if tipo == "string" {
+		return value.(int), nil
+	}
+	temp, err := NewTemp("string")
+	if err != nil {
+		return 0, err
+	}
+	PushQuadAt(PosOf(&token.Token{Pos: pos}), TOSTR, value, enumOrBlank(tipo), temp)
+	return temp, nil
+}
+
+// expectStrings: Verifica que los argumentos sean de los tipos de la firma
+func expectStrings(call BuiltinCall, signature ...string) error {
+	if err := expectArgs(call, len(signature)); err != nil {
+		return err
+	}
+	for i, tipo := range signature {
+		if call.Types[i] != tipo {
+			return fmt.Errorf("error: el argumento %d de %s debe ser %s, recibió %s", i+1, call.Name, tipo, call.Types[i])
+		}
+	}
+	return nil
+}
+
+// builtinSubstr: substr(s, i, n) regresa n caracteres desde el índice i
+func builtinSubstr(call BuiltinCall) error {
+	if err := expectStrings(call, "string", "int", "int"); err != nil {
+		return err
+	}
+	temp, err := pushResult("string")
+	if err != nil {
+		return err
+	}
+	bounds := SubstrRange{Start: call.Args[1].(int), Count: call.Args[2].(int)}
+	PushQuadAt(PosOf(call.Token), SUBSTR, call.Args[0], bounds, temp)
+	return nil
+}
+
+// builtinIndexOf: indexOf(s, t) regresa dónde aparece t por primera vez (-1 si no aparece)
+func builtinIndexOf(call BuiltinCall) error {
+	if err := expectStrings(call, "string", "string"); err != nil {
+		return err
+	}
+	temp, err := pushResult("int")
+	if err != nil {
+		return err
+	}
+	PushQuadAt(PosOf(call.Token), INDEXOF, call.Args[0], call.Args[1], temp)
+	return nil
+}
+
+// builtinCase: upper(s) y lower(s)
+func builtinCase(oper int) func(call BuiltinCall) error {
+	return func(call BuiltinCall) error {
+		if err := expectStrings(call, "string"); err != nil {
+			return err
+		}
+		temp, err := pushResult("string")
+		if err != nil {
+			return err
+		}
+		PushQuadAt(PosOf(call.Token), oper, call.Args[0], "_", temp)
+		return nil
+	}
+}
+
+// builtinParse: toInt(s) y toFloat(s), si el texto no es número es error de ejecución
+func builtinParse(oper int, tipo string) func(call BuiltinCall) error {
+	return func(call BuiltinCall) error {
+		if err := expectStrings(call, "string"); err != nil {
+			return err
+		}
+		temp, err := pushResult(tipo)
+		if err != nil {
+			return err
+		}
+		PushQuadAt(PosOf(call.Token), oper, call.Args[0], "_", temp)
+		return nil
+	}
+}
+
+// builtinStr: str(x) es el texto de x, igual que como lo imprime print
+func builtinStr(call BuiltinCall) error {
+	if err := expectArgs(call, 1); err != nil {
+		return err
+	}
+	if IsGeneratorType(call.Types[0]) {
+		return fmt.Errorf("error: str no acepta un generador")
+	}
+	addr, err := toText(call.Args[0], call.Types[0], call.Token.Pos)
+	if err != nil {
+		return err
+	}
+	PilaO.Push(addr)
+	PTypes.Push("string")
+	return nil
+}
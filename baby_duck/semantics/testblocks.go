@@ -0,0 +1,96 @@
+package semantics
+
+import (
+	"baby_duck/token"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// -------------------------------------------- TEST BLOCKS --------------------------------------------
+
+// Cuádruplos que preparan las globales antes de main (listas globales y bloque init), cada test los corre primero
+var setupStart, setupEnd int
+
+// HandleTestHeader: test "nombre" { ... }; se compila aparte, como una función sin parámetros
+// (test no es palabra clave para que siga sirviendo como nombre de función)
+func HandleTestHeader(idToken, nameToken interface{}) error {
+	idTok := idToken.(*token.Token)
+	if string(idTok.Lit) != "test" {
+		return fmt.Errorf("error: se esperaba una función o un bloque test, se encontró '%s'", idTok.Lit)
+	}
+
+	name, err := strconv.Unquote(string(nameToken.(*token.Token).Lit))
+	if err != nil {
+		return fmt.Errorf("error: nombre de test inválido %s", nameToken.(*token.Token).Lit)
+	}
+	if _, exists := TestDirectory.Get(name); exists {
+		return fmt.Errorf("error: test '%s' ya declarado", name)
+	}
+
+	TestDirectory.Put(name, TestStructure{Name: name, StartQuad: len(Quads), Line: idTok.Pos.Line})
+	CurrentFunction = "test " + strconv.Quote(name)
+
+	// Las variables del test van en un scope local (las listas globales las crea la preparación)
+	Scopes.EnterScope()
+	return nil
+}
+
+// HandleTestBlock: Cierra el test, al llegar al final la VM se detiene
+func HandleTestBlock() error {
+	if !strings.HasPrefix(CurrentFunction, "test ") {
+		return fmt.Errorf("error interno: cierre de test sin encabezado")
+	}
+	PushQuad(END, "_", "_", "_")
+	Scopes.ExitScope()
+	TempVar = 0
+	return nil
+}
+
+// Tests: Bloques test en el orden en que aparecen en el código
+func Tests() []TestStructure {
+	var tests []TestStructure
+	for _, raw := range TestDirectory.Items {
+		tests = append(tests, raw.(TestStructure))
+	}
+	sort.Slice(tests, func(i, j int) bool { return tests[i].StartQuad < tests[j].StartQuad })
+	return tests
+}
+
+// RunTest: Ejecuta un test en una VM nueva: primero la preparación de main (init incluido), luego el test
+func RunTest(test TestStructure) TestResult {
+	var out bytes.Buffer
+	vm := NewVirtualMachine(Quads, FunctionDirectory)
+	vm.Output = &out
+	vm.start()
+	vm.IP = setupStart
+	err := vm.execute(setupEnd)
+	if err == nil {
+		vm.IP = test.StartQuad
+		err = vm.execute(-1)
+	}
+	return TestResult{Test: test, Err: err, Output: out.String()}
+}
+
+// RunTests: Ejecuta todos los tests y escribe el reporte, regresa cuántos pasaron y fallaron
+func RunTests(w io.Writer) (passed, failed int) {
+	for _, test := range Tests() {
+		result := RunTest(test)
+		if result.Err == nil {
+			passed++
+			fmt.Fprintf(w, "PASS  %s\n", test.Name)
+			continue
+		}
+
+		failed++
+		fmt.Fprintf(w, "FAIL  %s (línea %d)\n      %v\n", test.Name, test.Line, result.Err)
+		if result.Output != "" {
+			fmt.Fprintf(w, "      salida: %q\n", result.Output)
+		}
+	}
+	fmt.Fprintf(w, "%d pasaron, %d fallaron\n", passed, failed)
+	return passed, failed
+}
@@ -1,5 +1,10 @@
 package semantics
 
+import (
+	"baby_duck/token"
+	"io"
+)
+
 // ------------------------------------------ VARS ------------------------------------------
 
 // Dictionary: Almacena pares clave-valor
@@ -16,25 +21,50 @@ type ScopeManager struct {
 
 // VariableStructure: Estructura de una variable
 type VariableStructure struct {
-	Name    string // Nombre
-	Type    string // Tipo
-	Address int    // Direccion
+	Name     string // Nombre
+	Type     string // Tipo
+	Address  int    // Direccion
+	Variadic bool   // Parámetro que recibe los argumentos extra como lista (nums: int...)
 }
 
 // FunctionStructure: Estructura de una función
 type FunctionStructure struct {
 	Name          string              // Nombre
+	Signature     string              // Nombre con tipos (llave en FunctionDirectory)
 	Parameters    []VariableStructure // Lista de parametros
+	Defaults      map[int]int         // Índice de parámetro → dirección de su constante por defecto
 	VarTable      *Dictionary         // Variables locales (scope local)
 	ParamCount    int                 // Número de parámetros
 	LocalVarCount int                 // Variables locales
 	StartQuad     int                 // Cuadruplo inicial
 	TempCount     int                 // Temporales Usados
+	Yields        string              // Tipo que produce si es generador ("" en funciones void)
+	Returns       []VariableStructure // Casillas globales de los valores de retorno (func ...: (tipos))
+}
+
+// TestStructure: Bloque test "nombre" { ... }; que se ejecuta con el comando test
+type TestStructure struct {
+	Name      string // Nombre del test
+	StartQuad int    // Cuádruplo inicial (termina con END)
+	Line      int    // Línea donde se declaró
+}
+
+// TestResult: Resultado de ejecutar un bloque test
+type TestResult struct {
+	Test   TestStructure // Test ejecutado
+	Err    error         // Error de ejecución (nil si pasó)
+	Output string        // Salida capturada
+}
+
+// EnumStructure: Estructura de un enum (cada miembro vale su posición)
+type EnumStructure struct {
+	Name    string   // Nombre del tipo
+	Members []string // Miembros en orden
 }
 
 // FuncInfo: Helper para pasar nombre+params
 type FuncInfo struct {
-	Name   string
+	Name   string // Firma de la función (ver MangleName)
 	Params []VariableStructure
 }
 
@@ -55,6 +85,14 @@ type QuadStructure struct {
 	Left   interface{} // Operando izquierdo
 	Right  interface{} // Operando derecho
 	Result interface{} // Resultado
+	Pos    SourcePos   // Posición en el código fuente
+}
+
+// SourcePos: Posición de un cuádruplo en el código fuente
+type SourcePos struct {
+	Line     int    // Línea (0 si no se conoce)
+	Column   int    // Columna
+	Function string // Función donde se generó
 }
 
 // ----------------------------------------- MEMORIA -----------------------------------------
@@ -72,6 +110,8 @@ type SegmentGroup struct {
 	Floats  MemorySegment
 	Strings MemorySegment
 	Bools   MemorySegment
+	BigInts MemorySegment
+	Refs    MemorySegment // Referencias a objetos del heap (listas, mapas, pilas, colas)
 }
 
 // MemoryManager: Administrador principal de memoria
@@ -88,15 +128,126 @@ type MemoryManager struct {
 type ActivationRecord struct {
 	ReturnIP int                 // Direccion de instruccion (IP) a la que debe regresar
 	LocalMem map[int]interface{} // Memoria local funcion llamadora
+	Resumed  *GeneratorObject    // Generador que se reanudó con esta llamada (nil en GOSUB)
 }
 
 // VirtualMachine: Nucelo de la VM
 type VirtualMachine struct {
-	Quads        []QuadStructure              // Lista de cuadruplos a ejecutar
-	GlobalMemory map[int]interface{}          // Memoria global (variables + constantes)
-	LocalMemory  map[int]interface{}          // Memoria local (función actual)
-	IP           int                          // Pointer (índice actual)
-	CallStack    []ActivationRecord           // Pila de llamadas (para las funciones)
-	FuncDir      map[string]FunctionStructure // Directorio de funciones
-	PendingAR    map[int]interface{}          // Registro de activación pendiente (para ERA)
+	Quads         []QuadStructure              // Lista de cuadruplos a ejecutar
+	GlobalMemory  map[int]interface{}          // Memoria global (variables + constantes)
+	LocalMemory   map[int]interface{}          // Memoria local (función actual)
+	IP            int                          // Pointer (índice actual)
+	CallStack     []ActivationRecord           // Pila de llamadas (para las funciones)
+	FuncDir       map[string]FunctionStructure // Directorio de funciones
+	PendingAR     map[int]interface{}          // Registro de activación pendiente (para ERA)
+	Handlers      []ExceptionHandler           // Bloques try activos
+	Exception     *RuntimeError                // Excepción que se está atrapando
+	Output        io.Writer                    // Salida de print/write/printf
+	CheckOverflow bool                         // Error si una operación int se desborda (apagado por defecto)
+	Heap          *Heap                        // Objetos de listas, mapas, pilas y colas
+}
+
+// HeapRef: Referencia a un objeto del heap, es lo que guardan las variables de lista, mapa...
+type HeapRef int
+
+// Heap: Objetos vivos de la VM, se liberan con mark-and-sweep
+type Heap struct {
+	Objects     map[HeapRef]interface{} // Referencia → objeto
+	next        HeapRef                 // Siguiente referencia a asignar
+	threshold   int                     // Objetos vivos que disparan la siguiente recolección
+	collections int                     // Recolecciones hechas
+	allocated   int                     // Objetos creados en total
+	freed       int                     // Objetos liberados en total
+}
+
+// HeapStats: Estadísticas del heap para depurar o medir el recolector
+type HeapStats struct {
+	LiveObjects int // Objetos en el heap ahora
+	Collections int // Recolecciones hechas
+	Allocated   int // Objetos creados en total
+	Freed       int // Objetos liberados en total
+}
+
+// BuiltinCall: Llamada a una función predefinida con sus argumentos ya evaluados
+type BuiltinCall struct {
+	Name  string        // Nombre de la función
+	Token *token.Token  // Token del nombre (para la posición)
+	Args  []interface{} // Direcciones de los argumentos
+	Types []string      // Tipos de los argumentos
+}
+
+// ListObject: Lista dinámica en el heap de la VM (las variables guardan su HeapRef)
+type ListObject struct {
+	ElemType string        // Tipo de los elementos (int o float)
+	Items    []interface{} // Elementos en orden
+}
+
+// MapObject: Mapa en el heap de la VM, recorre sus claves en orden de inserción
+type MapObject struct {
+	KeyType   string                      // Tipo de las claves (int o string)
+	ValueType string                      // Tipo de los valores
+	Items     map[interface{}]interface{} // Clave → valor
+	Keys      []interface{}               // Claves en orden de inserción
+}
+
+// StackObject: Pila en el heap de la VM (Last In, First Out)
+type StackObject struct {
+	ElemType string        // Tipo de los elementos (int o float)
+	Items    []interface{} // El último es el de arriba
+}
+
+// QueueObject: Cola en el heap de la VM (First In, First Out)
+type QueueObject struct {
+	ElemType string        // Tipo de los elementos (int o float)
+	Items    []interface{} // El primero es el siguiente en salir
+}
+
+// GeneratorObject: Generador en el heap, guarda su memoria local y dónde continuar mientras está suspendido
+type GeneratorObject struct {
+	Function  string              // Firma de la función generadora
+	Yields    string              // Tipo que produce
+	LocalMem  map[int]interface{} // Memoria local (parámetros, variables y temporales)
+	IP        int                 // Cuádruplo donde continúa
+	Running   bool                // Se está ejecutando (no se puede reanudar otra vez)
+	Done      bool                // Llegó al final de su cuerpo
+	ValueAddr int                 // Dónde escribir el valor del yield (la variable del for)
+	OkAddr    int                 // Dónde escribir si produjo valor (false al terminar)
+	Handlers  []ExceptionHandler  // try abiertos mientras está suspendido (CallDepth relativo a su llamada)
+}
+
+// ContainerRef: Operando de lista o mapa con su nombre para los errores de índice o clave
+type ContainerRef struct {
+	Address int    // Dirección de la referencia
+	Name    string // Nombre en el código ("" si es temporal)
+}
+
+// SubstrRange: Inicio y longitud de substr (direcciones de los argumentos)
+type SubstrRange struct {
+	Start int // Dirección del índice inicial
+	Count int // Dirección del número de caracteres
+}
+
+// ExceptionHandler: Bloque try activo en la VM
+type ExceptionHandler struct {
+	CatchIP   int // Cuádruplo donde empieza el catch
+	CallDepth int // Tamaño de CallStack al entrar al try
+}
+
+// PosError: Error de compilación con la posición del token que lo causó (el parser le pone la del token siguiente)
+type PosError struct {
+	Pos token.Pos // Posición del token
+	Err error     // Descripción del error
+}
+
+// Error: Solo el mensaje, la posición la pone SourceError
+func (e *PosError) Error() string {
+	return e.Err.Error()
+}
+
+// RuntimeError: Error de ejecución con la posición del cuádruplo que falló
+type RuntimeError struct {
+	Code    int       // Código de la excepción (ver ErrDivisionByZero...)
+	Message string    // Descripción del error
+	Quad    int       // Índice del cuádruplo
+	Pos     SourcePos // Posición en el código fuente
 }
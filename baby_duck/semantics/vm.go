@@ -2,6 +2,9 @@ package semantics
 
 import (
 	"fmt"
+	"math"
+	"math/big"
+	"os"
 	"strconv"
 	"strings"
 )
@@ -26,6 +29,8 @@ func NewVirtualMachine(quads []QuadStructure, funcDir *Dictionary) *VirtualMachi
 		CallStack:    make([]ActivationRecord, 0),
 		FuncDir:      funcDirMap,
 		PendingAR:    nil,
+		Output:       os.Stdout,
+		Heap:         NewHeap(),
 	}
 }
 
@@ -35,6 +40,20 @@ func (vm *VirtualMachine) InitializeMemory() {
 	for addr, name := range AddressToName {
 		if strings.HasPrefix(name, "const_") {
 			valueStr := strings.TrimPrefix(name, "const_")
+			// Las constantes string se guardan ya sin comillas
+			if IsStringAddress(addr) {
+				vm.GlobalMemory[addr], _ = ConstString(addr)
+				continue
+			}
+
+			// Las constantes bigint tienen su propio segmento
+			if IsBigIntAddress(addr) {
+				if bigVal, ok := new(big.Int).SetString(valueStr, 10); ok {
+					vm.GlobalMemory[addr] = bigVal
+				}
+				continue
+			}
+
 			// Intentar convertir a int
 			if intVal, err := strconv.Atoi(valueStr); err == nil {
 				vm.GlobalMemory[addr] = intVal
@@ -67,11 +86,15 @@ func (vm *VirtualMachine) InitializeMemory() {
 // ReadMem: Retorna valor almacenado en una dirección de memoria
 func (vm *VirtualMachine) ReadMem(addr int) interface{} {
 	// Rangos de memoria global/constantes
-	if (addr >= 1000 && addr <= 2999) || (addr >= 8000) {
+	if IsGlobalAddress(addr) {
 		val, ok := vm.GlobalMemory[addr]
 		if !ok {
 			// Inicializar según tipo de dirección
-			if addr >= 1000 && addr <= 1999 {
+			if IsBigIntAddress(addr) {
+				val = big.NewInt(0) // bigint
+			} else if IsStringAddress(addr) {
+				val = "" // string
+			} else if addr >= 1000 && addr <= 1999 {
 				val = 0 // int
 			} else if addr >= 2000 && addr <= 2999 {
 				val = 0.0 // float
@@ -79,8 +102,6 @@ func (vm *VirtualMachine) ReadMem(addr int) interface{} {
 				val = 0 // int
 			} else if addr >= 9000 && addr <= 9999 {
 				val = 0.0 // float
-			} else if addr >= 10000 && addr <= 10999 {
-				val = "" // string
 			}
 			vm.GlobalMemory[addr] = val
 		}
@@ -91,7 +112,11 @@ func (vm *VirtualMachine) ReadMem(addr int) interface{} {
 	val, ok := vm.LocalMemory[addr]
 	if !ok {
 		// Inicializar según tipo de dirección
-		if addr >= 3000 && addr <= 3999 {
+		if IsBigIntAddress(addr) {
+			val = big.NewInt(0) // bigint
+		} else if IsStringAddress(addr) {
+			val = "" // string
+		} else if addr >= 3000 && addr <= 3999 {
 			val = 0 // int
 		} else if addr >= 4000 && addr <= 4999 {
 			val = 0.0 // float
@@ -109,7 +134,8 @@ func (vm *VirtualMachine) ReadMem(addr int) interface{} {
 
 // WriteMem: Escribe valor en memorial global o local
 func (vm *VirtualMachine) WriteMem(addr int, value interface{}) {
-	if (addr >= 1000 && addr <= 2999) || (addr >= 8000) {
+	value = StoreValue(addr, value)
+	if IsGlobalAddress(addr) {
 		vm.GlobalMemory[addr] = value
 	} else {
 		vm.LocalMemory[addr] = value
@@ -132,12 +158,19 @@ func (vm *VirtualMachine) LogMemory() {
 // -------------------------------------------- EXECUTE --------------------------------------------
 
 // ExecuteNext: Ejecuta el siguiente cuádruplo y devuelve false si terminó
-func (vm *VirtualMachine) ExecuteNext() bool {
+func (vm *VirtualMachine) ExecuteNext() (running bool, err error) {
 	// Verifica si IP se paso del numero de quadruplos
 	if vm.IP >= len(vm.Quads) {
-		return false
+		return false, nil
 	}
 
+	// Un panic (p. ej. una conversión de tipo fallida) se vuelve error de ejecución
+	defer func() {
+		if r := recover(); r != nil {
+			running, err = false, vm.NewRuntimeError(ErrInternal, fmt.Sprint(r))
+		}
+	}()
+
 	// Obtiene cuadruplo actual y avanza
 	quad := vm.Quads[vm.IP]
 	vm.IP++
@@ -151,6 +184,20 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		right := vm.ReadMem(quad.Right.(int))
 		resultAddr := quad.Result.(int)
 
+		// Modo revisado: una operación int que se desborda detiene la VM
+		if vm.CheckOverflow && FixedAddresses[quad.Oper] != "/" {
+			a, isIntA := left.(int)
+			b, isIntB := right.(int)
+			if isIntA && isIntB {
+				result, ok := CheckedArith(FixedAddresses[quad.Oper], a, b)
+				if !ok {
+					return false, vm.NewRuntimeError(ErrOverflow, fmt.Sprintf("desbordamiento de enteros: %d %s %d", a, FixedAddresses[quad.Oper], b))
+				}
+				vm.WriteMem(resultAddr, result)
+				break
+			}
+		}
+
 		// Ejecuta la operación
 		switch FixedAddresses[quad.Oper] {
 		case "+":
@@ -160,34 +207,78 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		case "*":
 			vm.WriteMem(resultAddr, Mul(left, right))
 		case "/":
+			if ToFloat(right) == 0 {
+				return false, vm.NewRuntimeError(ErrDivisionByZero, "división entre cero")
+			}
 			vm.WriteMem(resultAddr, Div(left, right))
 		}
 
-	case "<", ">", "!=", "==":
+	case "<", ">", "!=", "==", "<=", ">=":
 		// Lee operando izquierdo y derecho desde memoria
 		left := vm.ReadMem(quad.Left.(int))
 		right := vm.ReadMem(quad.Right.(int))
 		resultAddr := quad.Result.(int)
 
-		// Convertir para comparación
-		leftVal := ToFloat(left)
-		rightVal := ToFloat(right)
+		// Convertir para comparación (-1, 0, 1 como en big.Int.Cmp)
+		cmp := Compare(left, right)
 
 		// Ejecuta la evaluacion
 		var result bool
 		switch FixedAddresses[quad.Oper] {
 		case "<":
-			result = leftVal < rightVal
+			result = cmp < 0
 		case ">":
-			result = leftVal > rightVal
+			result = cmp > 0
 		case "!=":
-			result = leftVal != rightVal
+			result = cmp != 0
 		case "==":
-			result = leftVal == rightVal
+			result = cmp == 0
+		case "<=":
+			result = cmp <= 0
+		case ">=":
+			result = cmp >= 0
 		}
 
 		vm.WriteMem(resultAddr, result)
 
+	case "&", "|", "xor", "<<", ">>":
+		// Operadores de bits, solo int (revisado al compilar, se vuelve a revisar el valor)
+		left, err := vm.readInt(quad.Left.(int), FixedAddresses[quad.Oper])
+		if err != nil {
+			return false, err
+		}
+		right, err := vm.readInt(quad.Right.(int), FixedAddresses[quad.Oper])
+		if err != nil {
+			return false, err
+		}
+		resultAddr := quad.Result.(int)
+
+		switch FixedAddresses[quad.Oper] {
+		case "&":
+			vm.WriteMem(resultAddr, left&right)
+		case "|":
+			vm.WriteMem(resultAddr, left|right)
+		case "xor":
+			vm.WriteMem(resultAddr, left^right)
+		case "<<", ">>":
+			if right < 0 {
+				return false, vm.NewRuntimeError(ErrNegativeShift, fmt.Sprintf("corrimiento negativo: %d %s %d", left, FixedAddresses[quad.Oper], right))
+			}
+			if FixedAddresses[quad.Oper] == "<<" {
+				vm.WriteMem(resultAddr, left<<right)
+			} else {
+				vm.WriteMem(resultAddr, left>>right)
+			}
+		}
+
+	case "~":
+		// Complemento de bits
+		value, err := vm.readInt(quad.Left.(int), "~")
+		if err != nil {
+			return false, err
+		}
+		vm.WriteMem(quad.Result.(int), ^value)
+
 	case "=":
 		// Copia el valor de una dirección a otra
 		source := vm.ReadMem(quad.Left.(int))
@@ -195,23 +286,80 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		vm.WriteMem(destAddr, source)
 
 	case "PRINT":
-		addr := quad.Left.(int)
-		// Si es string constante, obtiene su valor
-		if addr >= 10000 && addr <= 10999 {
-			name, exists := AddressToName[addr]
-			if !exists {
-				panic("String no encontrada en AddressToName")
-			}
+		// Lee el valor de memoria (un enum imprime el nombre del miembro, una referencia su objeto)
+		value := vm.Resolve(vm.ReadMem(quad.Left.(int)))
+		fmt.Fprint(vm.Output, EnumMemberName(quad.Right, value))
 
-			value := strings.TrimPrefix(name, "const_")
-			value = strings.Trim(value, "\"")
+	case "PRINTLN":
+		// Termina la línea de print
+		fmt.Fprintln(vm.Output)
 
-			fmt.Println(value)
-		} else {
-			// Si no es string lee el valor de memoria
-			value := vm.ReadMem(addr)
-			fmt.Println(value)
+	case "PRINTF":
+		// Imprime un argumento con su verbo (%d, %.2f...) ya revisado al compilar
+		value := vm.Resolve(vm.ReadMem(quad.Left.(int)))
+		verb, _ := ConstString(quad.Right.(int))
+		if strings.HasSuffix(verb, "f") {
+			value = ToFloat(value)
+		} else if strings.HasSuffix(verb, "v") {
+			value = EnumMemberName(quad.Result, value)
+		}
+		fmt.Fprintf(vm.Output, verb, value)
+
+	case "TOSTR":
+		// Texto de un valor, igual que como lo imprime print
+		value := vm.Resolve(vm.ReadMem(quad.Left.(int)))
+		vm.WriteMem(quad.Result.(int), fmt.Sprint(EnumMemberName(quad.Right, value)))
+
+	case "TRUNC":
+		// int(x) de un float, hacia cero
+		vm.WriteMem(quad.Result.(int), int(ToFloat(vm.ReadMem(quad.Left.(int)))))
+
+	case "SUBSTR":
+		text := []rune(vm.ReadMem(quad.Left.(int)).(string))
+		bounds := quad.Right.(SubstrRange)
+		start := vm.ReadMem(bounds.Start).(int)
+		count := vm.ReadMem(bounds.Count).(int)
+		if start < 0 || count < 0 || start+count > len(text) {
+			return false, vm.NewRuntimeError(ErrIndexOutOfRange, fmt.Sprintf("substr(%d, %d) fuera de rango en string de longitud %d", start, count, len(text)))
 		}
+		vm.WriteMem(quad.Result.(int), string(text[start:start+count]))
+
+	case "INDEXOF":
+		// Posición en caracteres (-1 si no aparece)
+		text := vm.ReadMem(quad.Left.(int)).(string)
+		index := strings.Index(text, vm.ReadMem(quad.Right.(int)).(string))
+		if index > 0 {
+			index = len([]rune(text[:index]))
+		}
+		vm.WriteMem(quad.Result.(int), index)
+
+	case "UPPER":
+		vm.WriteMem(quad.Result.(int), strings.ToUpper(vm.ReadMem(quad.Left.(int)).(string)))
+
+	case "LOWER":
+		vm.WriteMem(quad.Result.(int), strings.ToLower(vm.ReadMem(quad.Left.(int)).(string)))
+
+	case "TOINT":
+		text := vm.ReadMem(quad.Left.(int)).(string)
+		value, err := strconv.Atoi(strings.TrimSpace(text))
+		if err != nil {
+			return false, vm.NewRuntimeError(ErrConversion, fmt.Sprintf("no se puede convertir %q a int", text))
+		}
+		vm.WriteMem(quad.Result.(int), value)
+
+	case "TOFLOAT":
+		// Un int se convierte directo; un string se interpreta (toFloat)
+		source := vm.ReadMem(quad.Left.(int))
+		text, isString := source.(string)
+		if !isString {
+			vm.WriteMem(quad.Result.(int), ToFloat(source))
+			break
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return false, vm.NewRuntimeError(ErrConversion, fmt.Sprintf("no se puede convertir %q a float", text))
+		}
+		vm.WriteMem(quad.Result.(int), value)
 
 	case "GOTOF":
 		conditionAddr := quad.Left.(int)
@@ -244,16 +392,9 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		funcData := vm.FuncDir[funcName]
 
 		// Crear memoria local usando los índices de parámetros
-		newLocal := make(map[int]interface{})
-		for paramIndex, paramValue := range vm.PendingAR {
-			// Asegura indice sea valido dentro del arreglo parametro
-			if paramIndex-1 < len(funcData.Parameters) && paramIndex-1 >= 0 {
-				paramAddr := funcData.Parameters[paramIndex-1].Address + 1 // Usa dirección del parámetro
-				newLocal[paramAddr] = paramValue
-
-			} else {
-				panic(fmt.Sprintf("Índice inválido: %d (parámetros: %d)", paramIndex, len(funcData.Parameters)))
-			}
+		newLocal, err := vm.bindParams(funcData)
+		if err != nil {
+			return false, err
 		}
 
 		// Guarda el estado actual pila llamadas
@@ -267,9 +408,24 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		vm.IP = funcData.StartQuad
 		vm.PendingAR = nil
 
+	case "ENUMCHECK":
+		// Color(n) con n que no es la posición de un miembro
+		enum, _ := LookupEnum(quad.Right.(string))
+		n, err := vm.readInt(quad.Left.(int), enum.Name+"()")
+		if err != nil {
+			return false, err
+		}
+		if n < 0 || n >= len(enum.Members) {
+			return false, vm.NewRuntimeError(ErrEnumRange, fmt.Sprintf("%s(%d) fuera de rango, '%s' tiene %d miembros", enum.Name, n, enum.Name, len(enum.Members)))
+		}
+
+	case "NORETURN":
+		// La función llegó al final sin return, sus casillas tendrían un valor viejo
+		return false, vm.NewRuntimeError(ErrMissingReturn, fmt.Sprintf("'%v' terminó sin return", quad.Left))
+
 	case "ENDFUNC":
 		if len(vm.CallStack) == 0 {
-			panic("ENDFUNC sin llamada activa")
+			return false, vm.NewRuntimeError(ErrInternal, "ENDFUNC sin llamada activa")
 		}
 
 		// Finaliza función actual restaura estado anterior
@@ -278,45 +434,714 @@ func (vm *VirtualMachine) ExecuteNext() bool {
 		vm.LocalMemory = frame.LocalMem
 		vm.IP = frame.ReturnIP
 
+		// Los try que quedaron abiertos en la función ya no la protegen
+		vm.dropHandlers(len(vm.CallStack))
+
+		// Un generador que llega al final termina el for-in que lo recorre
+		if gen := frame.Resumed; gen != nil {
+			gen.Running, gen.Done = false, true
+			gen.LocalMem = nil
+			vm.WriteMem(gen.OkAddr, false)
+		}
+
+	case "GENNEW":
+		// Crea el generador con sus parámetros, suspendido al inicio de su cuerpo
+		funcData := vm.FuncDir[quad.Left.(string)]
+		local, err := vm.bindParams(funcData)
+		if err != nil {
+			return false, err
+		}
+		// PendingAR se limpia hasta después de Alloc para que los parámetros sigan siendo raíces si recolecta
+		gen := vm.Alloc(&GeneratorObject{
+			Function: funcData.Signature,
+			Yields:   funcData.Yields,
+			LocalMem: local,
+			IP:       funcData.StartQuad,
+		})
+		vm.PendingAR = nil
+		vm.WriteMem(quad.Result.(int), gen)
+
+	case "RESUME":
+		// Continúa el generador donde se quedó, como una llamada que regresa en el siguiente yield
+		gen := vm.Deref(quad.Left.(int)).(*GeneratorObject)
+		if gen.Done {
+			return false, vm.NewRuntimeError(ErrGeneratorDone, fmt.Sprintf("el generador %s ya terminó", gen.Function))
+		}
+		if gen.Running {
+			return false, vm.NewRuntimeError(ErrGeneratorDone, fmt.Sprintf("el generador %s ya se está ejecutando", gen.Function))
+		}
+		gen.Running = true
+		gen.ValueAddr, gen.OkAddr = quad.Right.(int), quad.Result.(int)
+
+		vm.CallStack = append(vm.CallStack, ActivationRecord{
+			ReturnIP: vm.IP,
+			LocalMem: vm.LocalMemory,
+			Resumed:  gen,
+		})
+		vm.LocalMemory = gen.LocalMem
+		vm.IP = gen.IP
+
+		// Sus try vuelven a proteger el cuerpo, a la profundidad donde se reanudó
+		for _, handler := range gen.Handlers {
+			handler.CallDepth += len(vm.CallStack)
+			vm.Handlers = append(vm.Handlers, handler)
+		}
+		gen.Handlers = nil
+
+	case "YIELD":
+		// Suspende el generador guardando su memoria y regresa el valor al for-in
+		if len(vm.CallStack) == 0 || vm.CallStack[len(vm.CallStack)-1].Resumed == nil {
+			return false, vm.NewRuntimeError(ErrInternal, "yield fuera de un generador")
+		}
+		value := vm.ReadMem(quad.Left.(int))
+		frame := vm.CallStack[len(vm.CallStack)-1]
+		gen := frame.Resumed
+
+		// Los try abiertos del generador se guardan con él, no atrapan errores del for-in
+		depth := len(vm.CallStack)
+		for _, handler := range vm.Handlers {
+			if handler.CallDepth >= depth {
+				handler.CallDepth -= depth
+				gen.Handlers = append(gen.Handlers, handler)
+			}
+		}
+		vm.dropHandlers(depth - 1)
+		vm.CallStack = vm.CallStack[:len(vm.CallStack)-1]
+
+		gen.Running = false
+		gen.LocalMem, gen.IP = vm.LocalMemory, vm.IP
+		vm.LocalMemory, vm.IP = frame.LocalMem, frame.ReturnIP
+
+		if gen.Yields == "float" {
+			value = ToFloat(value)
+		}
+		vm.WriteMem(gen.ValueAddr, value)
+		vm.WriteMem(gen.OkAddr, true)
+
+	case "ASSERT":
+		condition := vm.ReadMem(quad.Left.(int)).(bool)
+		if !condition {
+			// Detiene la VM con el mensaje y la posición del assert
+			message := "aserción fallida"
+			if addr, ok := quad.Right.(int); ok {
+				if text, exists := ConstString(addr); exists {
+					message += ": " + text
+				}
+			}
+			return false, vm.NewRuntimeError(ErrAssertion, message)
+		}
+
+	case "CONTRACT":
+		// Detiene la VM si no se cumple un requires/ensures
+		if !vm.ReadMem(quad.Left.(int)).(bool) {
+			text, _ := ConstString(quad.Right.(int))
+			return false, vm.NewRuntimeError(ErrContract, fmt.Sprintf("contrato violado: %s", text))
+		}
+
+	case "TRY":
+		// Registra el bloque con la profundidad actual de llamadas
+		vm.Handlers = append(vm.Handlers, ExceptionHandler{
+			CatchIP:   quad.Result.(int),
+			CallDepth: len(vm.CallStack),
+		})
+
+	case "ENDTRY":
+		// El try terminó sin excepción
+		if len(vm.Handlers) == 0 {
+			return false, vm.NewRuntimeError(ErrInternal, "ENDTRY sin try activo")
+		}
+		vm.Handlers = vm.Handlers[:len(vm.Handlers)-1]
+
+	case "CATCH":
+		// Guarda el código de la excepción atrapada y, con catch (code, msg), su mensaje
+		vm.WriteMem(quad.Result.(int), vm.Exception.Code)
+		if msgAddr, ok := quad.Left.(int); ok {
+			vm.WriteMem(msgAddr, vm.Exception.Message)
+		}
+
+	case "THROW":
+		value := vm.ReadMem(quad.Left.(int))
+		if text, isString := value.(string); isString {
+			return false, vm.NewRuntimeError(ErrThrownString, text)
+		}
+		code := value.(int)
+		if code <= 0 {
+			// 0 y los negativos son de la VM, si no un catch no distinguiría throw 0 - 1 de una división entre cero
+			return false, vm.NewRuntimeError(ErrThrowCode, fmt.Sprintf("throw con código %d, los códigos de usuario deben ser positivos", code))
+		}
+		return false, vm.NewRuntimeError(code, fmt.Sprintf("excepción %d", code))
+
+	case "NEW":
+		// Crea el objeto en el heap y guarda su referencia (puede disparar una recolección)
+		tipo := quad.Left.(string)
+		switch {
+		case IsMapType(tipo):
+			vm.WriteMem(quad.Result.(int), vm.Alloc(&MapObject{
+				KeyType:   MapKeyType(tipo),
+				ValueType: MapValueType(tipo),
+				Items:     make(map[interface{}]interface{}),
+			}))
+		case IsStackType(tipo):
+			vm.WriteMem(quad.Result.(int), vm.Alloc(&StackObject{ElemType: StackElemType(tipo)}))
+		case IsQueueType(tipo):
+			vm.WriteMem(quad.Result.(int), vm.Alloc(&QueueObject{ElemType: StackElemType(tipo)}))
+		default:
+			vm.WriteMem(quad.Result.(int), vm.Alloc(&ListObject{ElemType: ListElemType(tipo)}))
+		}
+
+	case "LISTAPPEND":
+		list := vm.Deref(quad.Left.(int)).(*ListObject)
+		list.Items = append(list.Items, list.convert(vm.ReadMem(quad.Right.(int))))
+
+	case "LEN":
+		// Caracteres de un string
+		if IsStringAddress(quad.Left.(int)) {
+			vm.WriteMem(quad.Result.(int), len([]rune(vm.ReadMem(quad.Left.(int)).(string))))
+			break
+		}
+
+		// Número de elementos de una lista, pila o cola, o de claves de un mapa
+		switch container := vm.Deref(quad.Left.(int)).(type) {
+		case *ListObject:
+			vm.WriteMem(quad.Result.(int), len(container.Items))
+		case *MapObject:
+			vm.WriteMem(quad.Result.(int), len(container.Keys))
+		case *StackObject:
+			vm.WriteMem(quad.Result.(int), container.Size())
+		case *QueueObject:
+			vm.WriteMem(quad.Result.(int), container.Size())
+		}
+
+	case "LISTGET":
+		ref := quad.Left.(ContainerRef)
+		list, index, err := vm.listIndex(ref, quad.Right.(int))
+		if err != nil {
+			return false, err
+		}
+		vm.WriteMem(quad.Result.(int), list.Items[index])
+
+	case "LISTSET":
+		ref := quad.Left.(ContainerRef)
+		list, index, err := vm.listIndex(ref, quad.Right.(int))
+		if err != nil {
+			return false, err
+		}
+		list.Items[index] = list.convert(vm.ReadMem(quad.Result.(int)))
+
+	case "MAPGET":
+		ref := quad.Left.(ContainerRef)
+		m := vm.Deref(ref.Address).(*MapObject)
+		key := vm.ReadMem(quad.Right.(int))
+		value, exists := m.Items[key]
+		if !exists {
+			return false, vm.NewRuntimeError(ErrKeyNotFound, fmt.Sprintf("clave %v no existe en mapa '%s'", key, containerName(ref)))
+		}
+		vm.WriteMem(quad.Result.(int), value)
+
+	case "MAPSET":
+		ref := quad.Left.(ContainerRef)
+		m := vm.Deref(ref.Address).(*MapObject)
+		m.Set(vm.ReadMem(quad.Right.(int)), vm.ReadMem(quad.Result.(int)))
+
+	case "MAPHAS":
+		m := vm.Deref(quad.Left.(int)).(*MapObject)
+		_, exists := m.Items[vm.ReadMem(quad.Right.(int))]
+		vm.WriteMem(quad.Result.(int), exists)
+
+	case "MAPDELETE":
+		m := vm.Deref(quad.Left.(int)).(*MapObject)
+		m.Delete(vm.ReadMem(quad.Right.(int)))
+
+	case "MAPKEY":
+		// Clave en la posición i (orden de inserción), usada por for-in
+		m := vm.Deref(quad.Left.(ContainerRef).Address).(*MapObject)
+		index := vm.ReadMem(quad.Right.(int)).(int)
+		vm.WriteMem(quad.Result.(int), m.Keys[index])
+
+	case "PUSH":
+		stack := vm.Deref(quad.Left.(int)).(*StackObject)
+		stack.Push(vm.ReadMem(quad.Right.(int)))
+
+	case "ENQUEUE":
+		queue := vm.Deref(quad.Left.(int)).(*QueueObject)
+		queue.Enqueue(vm.ReadMem(quad.Right.(int)))
+
+	case "POP", "DEQUEUE", "PEEK":
+		// Sacar o ver el siguiente elemento falla si el contenedor está vacío
+		var item interface{}
+		var err error
+		switch container := vm.Deref(quad.Left.(int)).(type) {
+		case *StackObject:
+			if FixedAddresses[quad.Oper] == "PEEK" {
+				item, err = container.Peek()
+			} else {
+				item, err = container.Pop()
+			}
+		case *QueueObject:
+			if FixedAddresses[quad.Oper] == "PEEK" {
+				item, err = container.Peek()
+			} else {
+				item, err = container.Dequeue()
+			}
+		}
+		if err != nil {
+			return false, vm.NewRuntimeError(ErrEmptyContainer, err.Error())
+		}
+		vm.WriteMem(quad.Result.(int), item)
+
+	case "ISEMPTY":
+		switch container := vm.Deref(quad.Left.(int)).(type) {
+		case *StackObject:
+			vm.WriteMem(quad.Result.(int), container.IsEmpty())
+		case *QueueObject:
+			vm.WriteMem(quad.Result.(int), container.IsEmpty())
+		}
+
 	case "END":
 		// Marca fin del programa
-		return false
+		return false, nil
 
 	default:
 		// Si es desconocida lanza error
-		panic(fmt.Sprintf("Operación no soportada: %d", quad.Oper))
+		return false, vm.NewRuntimeError(ErrInternal, fmt.Sprintf("Operación no soportada: %d", quad.Oper))
 	}
 
 	vm.LogMemory()
 
-	return true
+	return true, nil
+}
+
+// Run: Ejecuta desde IP (0 = el programa completo) hasta terminar o hasta un error de ejecución
+func (vm *VirtualMachine) Run() error {
+	vm.start()
+	return vm.execute(-1)
 }
 
-// Run: Ejecuta todos los cuádruplos hasta terminar
-func (vm *VirtualMachine) Run() {
+// start: Carga constantes y globales y crea la memoria de main
+func (vm *VirtualMachine) start() {
 	vm.InitializeMemory()
 	vm.LocalMemory = make(map[int]interface{}) // Memoria para main
 	vm.LogMemory()
-	for vm.ExecuteNext() {
+}
+
+// execute: Corre desde IP hasta END, o hasta llegar a stop fuera de cualquier llamada (-1 = sin límite)
+func (vm *VirtualMachine) execute(stop int) error {
+	for {
+		if vm.IP == stop && len(vm.CallStack) == 0 {
+			return nil
+		}
+		running, err := vm.ExecuteNext()
+		if err != nil {
+			// Si hay un try activo la excepción se atrapa, si no detiene la VM
+			rtErr, ok := err.(*RuntimeError)
+			if !ok || len(vm.Handlers) == 0 {
+				return err
+			}
+			vm.Unwind(rtErr)
+			continue
+		}
+		if !running {
+			return nil
+		}
 	}
 }
 
+// readInt: Lee un operando que debe ser int
+func (vm *VirtualMachine) readInt(addr int, oper string) (int, error) {
+	value := vm.ReadMem(addr)
+	n, ok := value.(int)
+	if !ok {
+		return 0, vm.NewRuntimeError(ErrTypeMismatch, fmt.Sprintf("el operador %s espera int, recibió %v", oper, value))
+	}
+	return n, nil
+}
+
+// dropHandlers: Quita los try de llamadas más profundas que depth (ya terminaron)
+func (vm *VirtualMachine) dropHandlers(depth int) {
+	for len(vm.Handlers) > 0 && vm.Handlers[len(vm.Handlers)-1].CallDepth > depth {
+		vm.Handlers = vm.Handlers[:len(vm.Handlers)-1]
+	}
+}
+
+// Unwind: Regresa al try más cercano descartando las llamadas que hizo
+func (vm *VirtualMachine) Unwind(exception *RuntimeError) {
+	handler := vm.Handlers[len(vm.Handlers)-1]
+	vm.Handlers = vm.Handlers[:len(vm.Handlers)-1]
+
+	// Los generadores que se estaban ejecutando no se pueden reanudar después
+	for _, frame := range vm.CallStack[min(handler.CallDepth, len(vm.CallStack)):] {
+		if frame.Resumed != nil {
+			frame.Resumed.Running, frame.Resumed.Done = false, true
+		}
+	}
+
+	// Restaura la memoria local de la función que tenía el try
+	if len(vm.CallStack) > handler.CallDepth {
+		vm.LocalMemory = vm.CallStack[handler.CallDepth].LocalMem
+		vm.CallStack = vm.CallStack[:handler.CallDepth]
+	}
+
+	vm.PendingAR = nil
+	vm.Exception = exception
+	vm.IP = handler.CatchIP
+}
+
+// -------------------------------------------- ERRORS --------------------------------------------
+
+// Códigos de las excepciones; throw con int usa el código que recibe (debe ser positivo)
+const (
+	ErrThrownString    = 0   // throw con mensaje string
+	ErrDivisionByZero  = -1  // División entre cero
+	ErrAssertion       = -2  // assert falso
+	ErrInternal        = -3  // Falla interna de la VM
+	ErrOverflow        = -4  // Desbordamiento de int (solo con CheckOverflow)
+	ErrNegativeShift   = -5  // Corrimiento de bits con cantidad negativa
+	ErrIndexOutOfRange = -6  // Índice fuera de los límites de una lista
+	ErrKeyNotFound     = -7  // Lectura de una clave que no existe en un mapa
+	ErrEmptyContainer  = -8  // pop/dequeue/peek sobre una pila o cola vacía
+	ErrGeneratorDone   = -9  // Reanudar un generador que ya terminó
+	ErrContract        = -10 // requires/ensures que no se cumple
+	ErrConversion      = -11 // toInt/toFloat de un string que no es número
+	ErrTypeMismatch    = -12 // Un operador recibió un valor de otro tipo
+	ErrMissingReturn   = -13 // Función con valores de retorno que termina sin return
+	ErrThrowCode       = -14 // throw con un código int que no es positivo
+	ErrEnumRange       = -15 // Conversión a enum de un int que no es miembro
+)
+
+// NewRuntimeError: Crea un error para el cuádruplo que se acaba de ejecutar
+func (vm *VirtualMachine) NewRuntimeError(code int, message string) *RuntimeError {
+	quadIndex := vm.IP - 1
+	err := &RuntimeError{Code: code, Message: message, Quad: quadIndex}
+	if quadIndex >= 0 && quadIndex < len(vm.Quads) {
+		err.Pos = vm.Quads[quadIndex].Pos
+	}
+	return err
+}
+
+// Error: Mensaje con línea, columna, función y cuádruplo (si se conocen)
+func (e *RuntimeError) Error() string {
+	if e.Pos.Line == 0 {
+		return fmt.Sprintf("error de ejecución en función '%s' (cuádruplo %d): %s", e.Pos.Function, e.Quad, e.Message)
+	}
+	return fmt.Sprintf("%d:%d: error de ejecución en función '%s' (cuádruplo %d): %s", e.Pos.Line, e.Pos.Column, e.Pos.Function, e.Quad, e.Message)
+}
+
+// -------------------------------------------- LISTS --------------------------------------------
+
+// listIndex: Obtiene la lista y el índice revisando los límites
+func (vm *VirtualMachine) listIndex(ref ContainerRef, indexAddr int) (*ListObject, int, error) {
+	list := vm.Deref(ref.Address).(*ListObject)
+	index := vm.ReadMem(indexAddr).(int)
+	if index < 0 || index >= len(list.Items) {
+		return nil, 0, vm.NewRuntimeError(ErrIndexOutOfRange, fmt.Sprintf("índice %d fuera de rango en lista '%s' (longitud %d)", index, containerName(ref), len(list.Items)))
+	}
+	return list, index, nil
+}
+
+// containerName: Nombre de la lista o mapa para los errores
+func containerName(ref ContainerRef) string {
+	if ref.Name == "" {
+		return "temporal"
+	}
+	return ref.Name
+}
+
+// convert: Un int guardado en una lista de float se vuelve float
+func (l *ListObject) convert(value interface{}) interface{} {
+	if l.ElemType == "float" {
+		return ToFloat(value)
+	}
+	return value
+}
+
+// String: Imprime la lista como [1, 2, 3]
+func (l *ListObject) String() string {
+	items := make([]string, len(l.Items))
+	for i, item := range l.Items {
+		items[i] = fmt.Sprint(item)
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+// -------------------------------------------- MAPS --------------------------------------------
+
+// Set: Guarda el valor, una clave nueva se agrega al final del orden
+func (m *MapObject) Set(key, value interface{}) {
+	if _, exists := m.Items[key]; !exists {
+		m.Keys = append(m.Keys, key)
+	}
+	if m.ValueType == "float" {
+		value = ToFloat(value)
+	}
+	m.Items[key] = value
+}
+
+// Delete: Quita la clave del mapa y de su orden (si existe)
+func (m *MapObject) Delete(key interface{}) {
+	if _, exists := m.Items[key]; !exists {
+		return
+	}
+	delete(m.Items, key)
+	for i, k := range m.Keys {
+		if k == key {
+			m.Keys = append(m.Keys[:i], m.Keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// String: Imprime el mapa como {ana: 20, luis: 31} en orden de inserción
+func (m *MapObject) String() string {
+	items := make([]string, len(m.Keys))
+	for i, key := range m.Keys {
+		items[i] = fmt.Sprintf("%v: %v", key, m.Items[key])
+	}
+	return "{" + strings.Join(items, ", ") + "}"
+}
+
+// bindParams: Crea la memoria local de una llamada con los parámetros del ERA pendiente
+func (vm *VirtualMachine) bindParams(funcData FunctionStructure) (map[int]interface{}, error) {
+	newLocal := make(map[int]interface{})
+	for paramIndex, paramValue := range vm.PendingAR {
+		// Asegura indice sea valido dentro del arreglo parametro
+		if paramIndex-1 < len(funcData.Parameters) && paramIndex-1 >= 0 {
+			paramAddr := funcData.Parameters[paramIndex-1].Address // Usa dirección del parámetro
+			newLocal[paramAddr] = StoreValue(paramAddr, paramValue)
+
+		} else {
+			return nil, vm.NewRuntimeError(ErrInternal, fmt.Sprintf("Índice inválido: %d (parámetros: %d)", paramIndex, len(funcData.Parameters)))
+		}
+	}
+	return newLocal, nil
+}
+
+// String: Imprime el generador con su firma
+func (g *GeneratorObject) String() string {
+	return "generator " + g.Function
+}
+
+// -------------------------------------------- STACKS & QUEUES --------------------------------------------
+
+// Push: Agrega elemento arriba de la pila
+func (s *StackObject) Push(item interface{}) {
+	if s.ElemType == "float" {
+		item = ToFloat(item)
+	}
+	s.Items = append(s.Items, item)
+}
+
+// Pop: Elimina y regresa último elemento
+func (s *StackObject) Pop() (interface{}, error) {
+	// Si la pila esta vacía, retorna error
+	if len(s.Items) == 0 {
+		return 0, fmt.Errorf("stack is empty")
+	}
+
+	lastIndex := len(s.Items) - 1 // Obtiene elemento
+	item := s.Items[lastIndex]    // Guarda elemento
+	s.Items = s.Items[:lastIndex] // Elimina elemento
+	return item, nil
+}
+
+// Peek: Regresa último elemento
+func (s *StackObject) Peek() (interface{}, error) {
+	if len(s.Items) == 0 {
+		return 0, fmt.Errorf("stack is empty")
+	}
+	return s.Items[len(s.Items)-1], nil
+}
+
+// IsEmpty: Verifica si la pila esta vacía
+func (s *StackObject) IsEmpty() bool {
+	return len(s.Items) == 0
+}
+
+// Size: Regresa cantidad de elementos en la pila
+func (s *StackObject) Size() int {
+	return len(s.Items)
+}
+
+// String: Imprime la pila de abajo hacia arriba como [1, 2, 3]
+func (s *StackObject) String() string {
+	return (&ListObject{Items: s.Items}).String()
+}
+
+// Enqueue: Agrega elemento al final de la cola
+func (q *QueueObject) Enqueue(item interface{}) {
+	if q.ElemType == "float" {
+		item = ToFloat(item)
+	}
+	q.Items = append(q.Items, item)
+}
+
+// Dequeue: Elimina y regresa el primer elemento
+func (q *QueueObject) Dequeue() (interface{}, error) {
+	// Si la cola esta vacía, retorna error
+	if len(q.Items) == 0 {
+		return 0, fmt.Errorf("queue is empty")
+	}
+
+	item := q.Items[0]    // Guarda elemento
+	q.Items = q.Items[1:] // Elimina elemento
+	return item, nil
+}
+
+// Peek: Regresa primer elemento
+func (q *QueueObject) Peek() (interface{}, error) {
+	if len(q.Items) == 0 {
+		return 0, fmt.Errorf("queue is empty")
+	}
+	return q.Items[0], nil
+}
+
+// IsEmpty: Verifica si la cola esta vacía
+func (q *QueueObject) IsEmpty() bool {
+	return len(q.Items) == 0
+}
+
+// Size: Regresa cantidad de elementos en la cola
+func (q *QueueObject) Size() int {
+	return len(q.Items)
+}
+
+// String: Imprime la cola del primero al último como [1, 2, 3]
+func (q *QueueObject) String() string {
+	return (&ListObject{Items: q.Items}).String()
+}
+
 // -------------------------------------------- FUN --------------------------------------------
 
-// ToFloat: Convierte int o float a float64
+// ConstString: Obtiene el texto (sin comillas) de una constante string
+func ConstString(addr int) (string, bool) {
+	name, exists := AddressToName[addr]
+	if !exists {
+		return "", false
+	}
+	value := strings.TrimPrefix(name, "const_")
+
+	// Interpreta secuencias de escape (\n, \t...)
+	if text, err := strconv.Unquote(value); err == nil {
+		return text, true
+	}
+	return strings.Trim(value, "\""), true
+}
+
+// EnumMemberName: Si enumName es un enum, regresa el nombre del miembro con ese valor
+func EnumMemberName(enumName interface{}, value interface{}) interface{} {
+	name, _ := enumName.(string)
+	enum, exists := LookupEnum(name)
+	index, isInt := value.(int)
+	if !exists || !isInt || index < 0 || index >= len(enum.Members) {
+		return value
+	}
+	return enum.Members[index]
+}
+
+// StoreValue: Convierte el valor al tipo del segmento destino (int a bigint)
+func StoreValue(addr int, value interface{}) interface{} {
+	if n, isInt := value.(int); isInt && IsBigIntAddress(addr) {
+		return big.NewInt(int64(n))
+	}
+	return value
+}
+
+// ToBig: Convierte int o bigint a *big.Int
+func ToBig(val interface{}) *big.Int {
+	switch v := val.(type) {
+	case int:
+		return big.NewInt(int64(v))
+	case *big.Int:
+		return v
+	default:
+		panic("Tipo no soportado para conversión a bigint")
+	}
+}
+
+// isBig: Indica si alguno de los operandos es bigint
+func isBig(a, b interface{}) bool {
+	_, isBigA := a.(*big.Int)
+	_, isBigB := b.(*big.Int)
+	return isBigA || isBigB
+}
+
+// Compare: Compara dos valores numéricos o dos strings, regresa -1, 0 o 1
+func Compare(a, b interface{}) int {
+	if left, isString := a.(string); isString {
+		return strings.Compare(left, b.(string))
+	}
+	if left, isBool := a.(bool); isBool {
+		// Solo == y != llegan con bool (false < true)
+		switch {
+		case left == b.(bool):
+			return 0
+		case left:
+			return 1
+		}
+		return -1
+	}
+	if isBig(a, b) {
+		return ToBig(a).Cmp(ToBig(b))
+	}
+	left, right := ToFloat(a), ToFloat(b)
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	}
+	return 0
+}
+
+// ToFloat: Convierte int, float o bigint a float64
 func ToFloat(val interface{}) float64 {
 	switch v := val.(type) {
 	case int:
 		return float64(v)
 	case float64:
 		return v
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(v).Float64()
+		return f
 	default:
 		panic("Tipo no soportado para conversión a float")
 	}
 }
 
+// CheckedArith: Suma, resta o multiplica ints, regresa false si el resultado se desborda
+func CheckedArith(op string, a, b int) (int, bool) {
+	switch op {
+	case "+":
+		result := a + b
+		// Desborda si ambos tienen el mismo signo y el resultado no
+		return result, (a >= 0) != (b >= 0) || (result >= 0) == (a >= 0)
+	case "-":
+		result := a - b
+		// Desborda si tienen signos distintos y el resultado cambia de signo respecto a a
+		return result, (a >= 0) == (b >= 0) || (result >= 0) == (a >= 0)
+	case "*":
+		if a == 0 || b == 0 {
+			return 0, true
+		}
+		result := a * b
+		// -1 * MinInt no se puede representar
+		if (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+			return result, false
+		}
+		return result, result/b == a
+	}
+	return 0, false
+}
+
 // Add: Realiza operación suma
 func Add(a, b interface{}) interface{} {
+	// Entre strings concatena
+	if left, isString := a.(string); isString {
+		return left + b.(string)
+	}
+	// Si alguno es bigint el resultado es bigint
+	if isBig(a, b) {
+		return new(big.Int).Add(ToBig(a), ToBig(b))
+	}
 	// Si al menos uno es float, convertir ambos
 	if _, isFloatA := a.(float64); isFloatA {
 		return ToFloat(a) + ToFloat(b)
@@ -330,6 +1155,9 @@ func Add(a, b interface{}) interface{} {
 
 // Sub: Realiza operación resta
 func Sub(a, b interface{}) interface{} {
+	if isBig(a, b) {
+		return new(big.Int).Sub(ToBig(a), ToBig(b))
+	}
 	if _, isFloatA := a.(float64); isFloatA {
 		return ToFloat(a) - ToFloat(b)
 	}
@@ -341,6 +1169,9 @@ func Sub(a, b interface{}) interface{} {
 
 // Mul: Realiza operación multiplicación
 func Mul(a, b interface{}) interface{} {
+	if isBig(a, b) {
+		return new(big.Int).Mul(ToBig(a), ToBig(b))
+	}
 	if _, isFloatA := a.(float64); isFloatA {
 		return ToFloat(a) * ToFloat(b)
 	}
@@ -352,6 +1183,10 @@ func Mul(a, b interface{}) interface{} {
 
 // Div: Realiza operación divición
 func Div(a, b interface{}) interface{} {
-	// La división siempre devuelve float
+	// Entre bigints la división trunca hacia cero
+	if isBig(a, b) {
+		return new(big.Int).Quo(ToBig(a), ToBig(b))
+	}
+	// Si no, la división siempre devuelve float
 	return ToFloat(a) / ToFloat(b)
 }
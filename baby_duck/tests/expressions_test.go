@@ -70,6 +70,20 @@ var testDataAccept3 = []*TI3{
          }
          end`,
 	}, // Accept 4: Prueba ciclo con simbolos relaciones
+	{
+		`program bits;
+         var a, b, c: int;
+         main {
+            a = 12;
+            b = 10;
+            c = a & b | a xor b << 1 >> 1;
+            c = ~a & (b | 1);
+            if ((a & 1) != 0) {
+               print(a);
+            };
+         }
+         end`,
+	}, // Accept 5: Operadores de bits con su jerarquía
 }
 
 func TestSemanticAccept3(t *testing.T) {
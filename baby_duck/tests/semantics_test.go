@@ -127,6 +127,36 @@ var testDataAccept2 = []*TI2{
 		 }
 		 end`,
 	}, // Accept 8: Llamar funcion desde otra funcion
+	{
+		`program overloads;
+		 var r: float;
+		 void area(r: float)[{
+			print(r * r * 3.14);
+		 }];
+		 void area(w: float, h: float)[{
+			print(w * h);
+		 }];
+		 void area(s: int)[{
+			print(s * s);
+		 }];
+		 main {
+			r = 2.0;
+			area(r);
+			area(2);
+			area(r, 3);
+		 }
+		 end`,
+	}, // Accept 9: Sobrecarga por número y tipo de parámetros
+	{
+		`program widening;
+		 var f: float;
+		 void sum(a: int, b: float)[{}];
+		 main {
+			sum(1, 2);
+			f = 3;
+		 }
+		 end`,
+	}, // Accept 10: Un int se amplía a float en parámetros y asignaciones
 }
 
 var testDataFail2 = []*TI2{
@@ -259,10 +289,99 @@ var testDataFail2 = []*TI2{
 		`program diffType;
 			void sum(a: int, b: float)[{}];
 			main {
-				sum(1, 2);
+				sum(1.5, 2);
+			}
+			end`,
+	}, // Fail 12: sum espera int float, recibe float int (solo int → float se amplía en un parámetro)
+	{
+		`program undeclCompound;
+			var x: int;
+			main {
+				y += x;
+			}
+			end`,
+	}, // Fail 13: Asignación compuesta a 'y' no declarada
+	{
+		`program ambiguous;
+			void f(a: int, b: float)[{}];
+			void f(a: float, b: int)[{}];
+			main {
+				f(1, 2);
+			}
+			end`,
+	}, // Fail 14: Llamada ambigua entre dos sobrecargas
+	{
+		`program noOverload;
+			void area(r: float)[{}];
+			void area(w: float, h: float)[{}];
+			main {
+				area(1.0, 2.0, 3.0);
+			}
+			end`,
+	}, // Fail 15: Ninguna sobrecarga acepta tres argumentos
+	{
+		`program dupOverload;
+			void area(r: float)[{}];
+			void area(x: float)[{}];
+			main {
+			}
+			end`,
+	}, // Fail 16: Misma firma declarada dos veces
+	{
+		`program unknownNamed;
+			void plot(x: int, y: int, color: int = 1)[{}];
+			main {
+				plot(1, 2, size: 3);
+			}
+			end`,
+	}, // Fail 17: Argumento con nombre que no existe
+	{
+		`program dupNamed;
+			void plot(x: int, y: int, color: int = 1)[{}];
+			main {
+				plot(1, 2, x: 3);
+			}
+			end`,
+	}, // Fail 18: Parámetro 'x' recibe dos valores
+	{
+		`program positionalAfterNamed;
+			void plot(x: int, y: int, color: int = 1)[{}];
+			main {
+				plot(y: 2, 1);
+			}
+			end`,
+	}, // Fail 19: Argumento posicional después de uno con nombre
+	{
+		`program missingArg;
+			void plot(x: int, y: int, color: int = 1)[{}];
+			main {
+				plot(color: 2, x: 1);
+			}
+			end`,
+	}, // Fail 20: Falta 'y', que no tiene valor por defecto
+	{
+		`program defaultOrder;
+			void plot(x: int = 0, y: int)[{}];
+			main {
+			}
+			end`,
+	}, // Fail 21: Parámetro sin valor por defecto después de uno con valor por defecto
+	{
+		`program badDefault;
+			void plot(x: int = 1.5)[{}];
+			main {
+			}
+			end`,
+	}, // Fail 22: Valor por defecto float para parámetro int
+	{
+		`program typoEnum;
+			enum Color { Red, Green };
+			var c: Colour;
+			main {
+				c = Color.Red;
 			}
 			end`,
-	}, // Fail 12: sum espera int float, recibe dos ints
+	}, // Fail 23: Variable con un tipo (enum) que no existe
 }
 
 func TestSemanticAccept2(t *testing.T) {
@@ -121,6 +121,25 @@ var testDataAccept4 = []*TI4{
          }
          end`,
 	}, // Accept 5: Uso de if con ciclo while-do
+	{
+		`program compound;
+         var i, j : int;
+         var f : float;
+         main {
+            i = 0;
+            j = 10;
+            while (i < j) do {
+               i += 2 * (j - 8);
+               j--;
+            };
+            i++;
+            i -= j;
+            f = 1.5;
+            f *= i + 0.5;
+            f /= 2;
+         }
+         end`,
+	}, // Accept 6: Asignación compuesta, incremento y decremento
 }
 
 func TestSemanticAccept(t *testing.T) {
@@ -138,78 +138,166 @@ var TokMap = TokenMap{
 	typeMap: []string{
 		"INVALID",
 		"␚",
+		"interp_start",
 		"main",
 		"program",
 		"id",
 		"semicolon",
 		"end",
 		"empty",
+		"init",
+		"cte_string",
+		"enum",
+		"l_curly_par",
+		"r_curly_par",
 		"var",
 		"colon",
 		"comma",
 		"int",
 		"float",
+		"bigint",
+		"string",
+		"list",
+		"of",
+		"stack",
+		"queue",
+		"map",
+		"l_square_par",
+		"r_square_par",
 		"void",
 		"l_round_par",
 		"r_round_par",
-		"l_square_par",
-		"r_square_par",
-		"l_curly_par",
-		"r_curly_par",
+		"generator",
+		"func",
+		"requires",
+		"ensures",
 		"assign",
+		"rest",
+		"ellipsis",
+		"return",
+		"infer_assign",
+		"increment",
+		"decrement",
+		"add_assign",
+		"rest_assign",
+		"mul_assign",
+		"div_assign",
 		"if",
 		"else",
 		"while",
 		"do",
+		"for",
+		"in",
 		"print",
-		"cte_string",
+		"write",
+		"printf",
+		"assert",
+		"try",
+		"catch",
+		"throw",
+		"yield",
+		"question",
+		"bit_or",
+		"xor",
+		"bit_and",
+		"shift_left",
+		"shift_right",
 		"less_than",
 		"more_than",
 		"not_equal",
+		"equal",
+		"less_equal",
+		"more_equal",
 		"add",
-		"rest",
 		"multiply",
 		"divide",
+		"dot",
+		"bit_not",
 		"cte_int",
 		"cte_float",
+		"cte_bigint",
 	},
 
 	idMap: map[string]Type{
 		"INVALID":      0,
 		"␚":            1,
-		"main":         2,
-		"program":      3,
-		"id":           4,
-		"semicolon":    5,
-		"end":          6,
-		"empty":        7,
-		"var":          8,
-		"colon":        9,
-		"comma":        10,
-		"int":          11,
-		"float":        12,
-		"void":         13,
-		"l_round_par":  14,
-		"r_round_par":  15,
-		"l_square_par": 16,
-		"r_square_par": 17,
-		"l_curly_par":  18,
-		"r_curly_par":  19,
-		"assign":       20,
-		"if":           21,
-		"else":         22,
-		"while":        23,
-		"do":           24,
-		"print":        25,
-		"cte_string":   26,
-		"less_than":    27,
-		"more_than":    28,
-		"not_equal":    29,
-		"add":          30,
-		"rest":         31,
-		"multiply":     32,
-		"divide":       33,
-		"cte_int":      34,
-		"cte_float":    35,
+		"interp_start": 2,
+		"main":         3,
+		"program":      4,
+		"id":           5,
+		"semicolon":    6,
+		"end":          7,
+		"empty":        8,
+		"init":         9,
+		"cte_string":   10,
+		"enum":         11,
+		"l_curly_par":  12,
+		"r_curly_par":  13,
+		"var":          14,
+		"colon":        15,
+		"comma":        16,
+		"int":          17,
+		"float":        18,
+		"bigint":       19,
+		"string":       20,
+		"list":         21,
+		"of":           22,
+		"stack":        23,
+		"queue":        24,
+		"map":          25,
+		"l_square_par": 26,
+		"r_square_par": 27,
+		"void":         28,
+		"l_round_par":  29,
+		"r_round_par":  30,
+		"generator":    31,
+		"func":         32,
+		"requires":     33,
+		"ensures":      34,
+		"assign":       35,
+		"rest":         36,
+		"ellipsis":     37,
+		"return":       38,
+		"infer_assign": 39,
+		"increment":    40,
+		"decrement":    41,
+		"add_assign":   42,
+		"rest_assign":  43,
+		"mul_assign":   44,
+		"div_assign":   45,
+		"if":           46,
+		"else":         47,
+		"while":        48,
+		"do":           49,
+		"for":          50,
+		"in":           51,
+		"print":        52,
+		"write":        53,
+		"printf":       54,
+		"assert":       55,
+		"try":          56,
+		"catch":        57,
+		"throw":        58,
+		"yield":        59,
+		"question":     60,
+		"bit_or":       61,
+		"xor":          62,
+		"bit_and":      63,
+		"shift_left":   64,
+		"shift_right":  65,
+		"less_than":    66,
+		"more_than":    67,
+		"not_equal":    68,
+		"equal":        69,
+		"less_equal":   70,
+		"more_equal":   71,
+		"add":          72,
+		"multiply":     73,
+		"divide":       74,
+		"dot":          75,
+		"bit_not":      76,
+		"cte_int":      77,
+		"cte_float":    78,
+		"cte_bigint":   79,
 	},
 }
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"baby_duck/dialect"
 	"baby_duck/lexer"
 	"baby_duck/parser"
 	"baby_duck/semantics"
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -75,6 +78,224 @@ var testDataAccept4 = []*TI4{
 		 }
 	 	 end`,
 	},
+	{
+		`program Asserts;
+		 var x: int;
+
+		 void check(v: int) [{
+			assert(v > 0, "v must be positive");
+		 }];
+
+		 main {
+			x = 3;
+			assert(x > 0, "x must be positive");
+			assert(x != 4);
+			check(x);
+		 }
+		 end`,
+	},
+	{
+		`program Exceptions;
+		 var x, y: int;
+		 var z: float;
+
+		 void validate(v: int) [{
+			if (v < 0) {
+				throw 7;
+			};
+			print("valor valido");
+		 }];
+
+		 void deeper(v: int) [{
+			validate(v);
+			print("no debe imprimirse");
+		 }];
+
+		 main {
+			x = 0 - 5;
+			try {
+				deeper(x);
+			} catch (e) {
+				assert(e != 6);
+				assert(e > 6, "codigo del throw");
+			};
+
+			y = 0;
+			try {
+				z = 10 / y;
+			} catch (err) {
+				assert(err < 0, "division entre cero es error de la VM");
+			};
+
+			try {
+				throw "fallo con mensaje";
+			} catch (e) {
+				print(e);
+			};
+			print("sigue despues del catch");
+		 }
+		 end`,
+	},
+	{
+		`program Compound;
+		 var i, j: int;
+		 var f: float;
+		 main {
+			i = 1;
+			i += 2 * 3;
+			assert(i != 6);
+			assert(i > 6);
+			assert(i < 8, "i += 2 * 3");
+			i -= 2;
+			i *= 3;
+			assert(i > 14);
+			assert(i < 16, "i = (7 - 2) * 3");
+			i++;
+			i++;
+			j--;
+			assert(i != 16);
+			assert(j < 0);
+			f = 5;
+			f /= 2;
+			assert(f > 2.4);
+			assert(f < 2.6, "f /= 2");
+		 }
+		 end`,
+	},
+}
+
+// Programas que compilan pero deben detenerse con un error de ejecución
+var testDataRuntimeFail4 = []*TI4{
+	{
+		`program FailedAssert;
+		 var x: int;
+		 main {
+			x = 0;
+			assert(x > 0, "x must be positive");
+			print("no debe imprimirse");
+		 }
+		 end`,
+	}, // Fail 1: assert falso en main
+	{
+		`program FailedAssertInFunc;
+		 void check(v: int) [{
+			assert(v < 10);
+		 }];
+		 main {
+			check(20);
+		 }
+		 end`,
+	}, // Fail 2: assert falso dentro de una función
+	{
+		`program UncaughtThrow;
+		 void fail() [{
+			throw "sin catch";
+		 }];
+		 main {
+			fail();
+		 }
+		 end`,
+	}, // Fail 3: throw sin try que lo atrape
+	{
+		`program DivByZero;
+		 var a: int;
+		 var b: float;
+		 main {
+			b = 1 / a;
+		 }
+		 end`,
+	}, // Fail 4: División entre cero sin try
+	{
+		`program OutOfRange;
+		 var l: list of int;
+		 main {
+			append(l, 1);
+			l[3] = 2;
+		 }
+		 end`,
+	}, // Fail 5: Índice fuera de rango
+	{
+		`program MissingKey;
+		 var ages: map[string]int;
+		 main {
+			ages["ana"] = 20;
+			print(ages["luis"]);
+		 }
+		 end`,
+	}, // Fail 6: Clave que no existe en el mapa
+	{
+		`program EmptyQueue;
+		 var q: queue of int;
+		 var x: int;
+		 main {
+			enqueue(q, 1);
+			x = dequeue(q) + dequeue(q);
+		 }
+		 end`,
+	}, // Fail 7: dequeue sobre una cola vacía
+	{
+		`program Exhausted;
+		 generator int count(n: int) [
+			var i: int;
+			{
+				while (i < n) do {
+					yield i;
+					i += 1;
+				};
+			}
+		 ];
+		 main {
+			var g := count(2);
+			for a in g do {
+				print(a);
+			};
+			for b in g do {
+				print(b);
+			};
+		 }
+		 end`,
+	}, // Fail 8: Reanudar un generador que ya terminó
+	{
+		`program SubstrRange;
+		 var s: string;
+		 main {
+			s = "pato";
+			print(substr(s, 2, 5));
+		 }
+		 end`,
+	}, // Fail 9: substr fuera del string
+	{
+		`program BadNumber;
+		 var n: int;
+		 main {
+			n = toInt("12a");
+		 }
+		 end`,
+	}, // Fail 10: toInt de un texto que no es número
+	{
+		`program MissingReturn;
+		 var n: int;
+		 func sign(x: int): int[{
+			if (x > 0) {
+				return 1;
+			};
+		 }];
+		 main {
+			n = sign(0 - 4);
+		 }
+		 end`,
+	}, // Fail 11: La función llega al final sin return en un camino
+	{
+		`program EnumRange;
+		 enum Color { Red, Green, Blue };
+		 var c: Color;
+		 var n: int;
+		 main {
+			n = 1;
+			c = Color(n + 2);
+		 }
+		 end`,
+	}, // Fail 12: Conversión a enum de un int calculado que no es miembro
 }
 
 func TestSemanticAccept(t *testing.T) {
@@ -98,7 +319,9 @@ func TestSemanticAccept(t *testing.T) {
 		// print(semantics.FunctionDirectory)
 		// fmt.Println("\n!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!")
 		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
-		vm.Run()
+		if err := vm.Run(); err != nil {
+			t.Errorf("Test %d (ACCEPT) failed: unexpected runtime error.\nError: %s", i+1, err.Error())
+		}
 
 		fmt.Println("\n===========================================================")
 
@@ -124,3 +347,1823 @@ func TestSemanticAccept(t *testing.T) {
 		*/
 	}
 }
+
+func TestRuntimeFail(t *testing.T) {
+	p := parser.NewParser()
+	for i, ts := range testDataRuntimeFail4 {
+		semantics.ResetSemanticState()
+
+		s := lexer.NewLexer([]byte(ts.src))
+		_, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("Test %d (RUNTIME FAIL) failed: unexpected parse error.\nError: %s", i+1, err.Error())
+			continue
+		}
+
+		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		err = vm.Run()
+		if err == nil {
+			t.Errorf("Test %d (RUNTIME FAIL) did not produce expected error.\nSource start: %.50s...", i+1, ts.src)
+			continue
+		}
+
+		// El error debe decir en qué línea y función ocurrió
+		rtErr, ok := err.(*semantics.RuntimeError)
+		if !ok || rtErr.Pos.Function == "" || rtErr.Pos.Line == 0 {
+			t.Errorf("Test %d (RUNTIME FAIL) error without source position: %v", i+1, err)
+			continue
+		}
+		t.Logf("Test %d (RUNTIME FAIL): Expected fail. Error: %s", i+1, err.Error())
+	}
+}
+
+var testDataOverflow4 = []*TI4{
+	{
+		`program AddOverflow;
+		 var n: int;
+		 main {
+			n = 9223372036854775807;
+			n = n + 1;
+		 }
+		 end`,
+	}, // Overflow 1: Suma
+	{
+		`program SubOverflow;
+		 var n: int;
+		 main {
+			n = 0 - 9223372036854775807;
+			n -= 2;
+		 }
+		 end`,
+	}, // Overflow 2: Resta en asignación compuesta
+	{
+		`program MulOverflow;
+		 var n: int;
+		 void grow(v: int)[{
+			print(v * v);
+		 }];
+		 main {
+			grow(4000000000);
+		 }
+		 end`,
+	}, // Overflow 3: Multiplicación dentro de una función
+}
+
+func TestCheckedOverflow(t *testing.T) {
+	p := parser.NewParser()
+	for i, ts := range testDataOverflow4 {
+		semantics.ResetSemanticState()
+
+		s := lexer.NewLexer([]byte(ts.src))
+		_, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("Test %d (OVERFLOW) failed: unexpected parse error.\nError: %s", i+1, err.Error())
+			continue
+		}
+
+		// Por defecto el desbordamiento no se revisa
+		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &bytes.Buffer{}
+		if err := vm.Run(); err != nil {
+			t.Errorf("Test %d (OVERFLOW) failed: unexpected error without CheckOverflow.\nError: %s", i+1, err.Error())
+			continue
+		}
+
+		// Con CheckOverflow debe fallar en la línea de la operación
+		vm = semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &bytes.Buffer{}
+		vm.CheckOverflow = true
+		err = vm.Run()
+		rtErr, ok := err.(*semantics.RuntimeError)
+		if !ok || rtErr.Code != semantics.ErrOverflow || rtErr.Pos.Line == 0 {
+			t.Errorf("Test %d (OVERFLOW) did not produce expected overflow error: %v", i+1, err)
+			continue
+		}
+		t.Logf("Test %d (OVERFLOW): Expected fail. Error: %s", i+1, err.Error())
+	}
+}
+
+var testDataContracts4 = []*TI4{
+	{
+		`program Requires;
+		 var total: int;
+		 void deposit(amount: int)
+			requires amount > 0;
+			requires amount < 1000;
+		 [{
+			total += amount;
+		 }];
+		 main {
+			deposit(10);
+			deposit(4500);
+		 }
+		 end`,
+	}, // Contract 1: requires que no se cumple
+	{
+		`program Ensures;
+		 var total, i: int;
+		 void deposit(amount: int)
+			ensures total > 0 ? total < 2000 : 1 < 0;
+		 [{
+			total += amount;
+		 }];
+		 main {
+			while (i < 3) do {
+				deposit(900);
+				i += 1;
+			};
+		 }
+		 end`,
+	}, // Contract 2: ensures con ?: que falla en la tercera llamada
+	{
+		`program EnsuresResult;
+		 var total, left: int;
+		 func deposit(amount: int): int
+			ensures result >= 0;
+		 [{
+			total += amount;
+			return 1000 - total;
+		 }];
+		 main {
+			left = deposit(600);
+			left = deposit(600);
+		 }
+		 end`,
+	}, // Contract 3: ensures sobre el valor de retorno que falla en la segunda llamada
+}
+
+func TestContracts(t *testing.T) {
+	p := parser.NewParser()
+	defer func() { semantics.StripContracts = false }()
+	for i, ts := range testDataContracts4 {
+		// Con contratos debe fallar nombrando la función y la cláusula
+		semantics.StripContracts = false
+		semantics.ResetSemanticState()
+		semantics.SetSource([]byte(ts.src))
+		if _, err := p.Parse(lexer.NewLexer([]byte(ts.src))); err != nil {
+			t.Errorf("Test %d (CONTRACT) failed: unexpected parse error.\nError: %s", i+1, err.Error())
+			continue
+		}
+		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &bytes.Buffer{}
+		err := vm.Run()
+		rtErr, ok := err.(*semantics.RuntimeError)
+		if !ok || rtErr.Code != semantics.ErrContract || rtErr.Pos.Function != "deposit" ||
+			!strings.HasPrefix(rtErr.Message, "contrato violado: requires amount") &&
+				!strings.HasPrefix(rtErr.Message, "contrato violado: ensures total") &&
+				!strings.HasPrefix(rtErr.Message, "contrato violado: ensures result") {
+			t.Errorf("Test %d (CONTRACT) did not produce expected contract error: %v", i+1, err)
+			continue
+		}
+		t.Logf("Test %d (CONTRACT): Expected fail. Error: %s", i+1, err.Error())
+
+		// Sin contratos el mismo programa termina bien
+		semantics.StripContracts = true
+		semantics.ResetSemanticState()
+		if _, err := p.Parse(lexer.NewLexer([]byte(ts.src))); err != nil {
+			t.Errorf("Test %d (CONTRACT) failed: unexpected parse error without contracts.\nError: %s", i+1, err.Error())
+			continue
+		}
+		vm = semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &bytes.Buffer{}
+		if err := vm.Run(); err != nil {
+			t.Errorf("Test %d (CONTRACT) failed: unexpected error without contracts.\nError: %s", i+1, err.Error())
+		}
+	}
+}
+
+func TestSpanishDialect(t *testing.T) {
+	english := `program Dialect;
+	 var i: int;
+	 var f: float;
+	 main {
+		while (i < 3) do {
+			if (i != 1) {
+				print(i, f);
+			} else {
+				print("uno");
+			};
+			i += 1;
+			f = f + 0.5;
+		};
+	 }
+	 end`
+	spanish := `#lang es
+	programa Dialect;
+	 var i: entero;
+	 var f: flotante;
+	 principal {
+		mientras (i < 3) hacer {
+			si (i != 1) {
+				imprimir(i, f);
+			} sino {
+				imprimir("uno");
+			};
+			i += 1;
+			f = f + 0.5;
+		};
+	 }
+	 fin`
+
+	// compile: Compila y regresa los cuádruplos sin posiciones y la salida
+	compile := func(src string) ([]string, string) {
+		semantics.ResetSemanticState()
+		scanner, err := dialect.NewScanner([]byte(src))
+		if err != nil {
+			t.Fatalf("unexpected dialect error: %s", err.Error())
+		}
+		if _, err := parser.NewParser().Parse(scanner); err != nil {
+			t.Fatalf("unexpected parse error: %s", err.Error())
+		}
+		quads := make([]string, len(semantics.Quads))
+		for i, q := range semantics.Quads {
+			quads[i] = fmt.Sprint(q.Oper, q.Left, q.Right, q.Result)
+		}
+		var out bytes.Buffer
+		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &out
+		if err := vm.Run(); err != nil {
+			t.Fatalf("unexpected runtime error: %s", err.Error())
+		}
+		return quads, out.String()
+	}
+
+	englishQuads, englishOut := compile(english)
+	spanishQuads, spanishOut := compile(spanish)
+	if strings.Join(englishQuads, "\n") != strings.Join(spanishQuads, "\n") {
+		t.Errorf("los dialectos generaron cuádruplos distintos:\n%v\n%v", englishQuads, spanishQuads)
+	}
+	if englishOut != "0 0\nuno\n2 1\n" || spanishOut != englishOut {
+		t.Errorf("salida inesperada: %q / %q", englishOut, spanishOut)
+	}
+
+	// Un dialecto que no existe es error
+	if _, err := dialect.NewScanner([]byte("#lang xx\nprogram P; main { } end")); err == nil {
+		t.Errorf("#lang xx no produjo error")
+	}
+}
+
+func TestInterpolationErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"program P;\nvar n: int;\nmain {\n  print(\"valor {n + zz} fin\");\n}\nend", "4:23: error: error: variable 'zz' no declarada"},
+		{"program P;\nmain {\n  print(\"a {} b\");\n}\nend", "3:13: error: {} sin expresión"},
+		{"program P;\nmain {\n  print(\"a {1 b\");\n}\nend", "'{' sin cerrar"},
+		{"program P;\nmain {\n  print(\"a { b\");\n}\nend", "usa {{ para escribirla"},
+		{"\x01 1 + 2", "1:1: error: error: carácter \\x01 inválido"},
+	}
+
+	// El error de una {expresión} apunta dentro del literal
+	for i, ts := range tests {
+		semantics.ResetSemanticState()
+		_, err := parser.NewParser().Parse(lexer.NewLexer([]byte(ts.src)))
+		if err == nil {
+			t.Errorf("Test %d (INTERP) did not produce expected error", i+1)
+			continue
+		}
+		if got := semantics.SourceError(err).Error(); !strings.Contains(got, ts.want) {
+			t.Errorf("Test %d (INTERP) failed.\nExpected: %q\nGot:      %q", i+1, ts.want, got)
+		}
+	}
+}
+
+func TestPrintfErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"program P;\nvar f: float;\nmain {\n  printf(\"%d\\n\", f);\n}\nend", "4:10: error: error: verbo %d no acepta un argumento de tipo float"},
+		{"program P;\nvar n: int;\nmain {\n  printf(\"%d y %d\\n\", n);\n}\nend", "4:10: error: error: printf tiene más verbos que argumentos (%d)"},
+		{"program P;\nvar n: int;\nmain {\n  printf(\"sin verbos\",\n    n);\n}\nend", "4:10: error: error: printf recibió 1 argumentos de más para el formato"},
+	}
+
+	// El error del formato apunta al literal, no al token que sigue a la llamada, y trae una sola posición
+	for i, ts := range tests {
+		semantics.ResetSemanticState()
+		_, err := parser.NewParser().Parse(lexer.NewLexer([]byte(ts.src)))
+		if err == nil {
+			t.Errorf("Test %d (PRINTF) did not produce expected error", i+1)
+			continue
+		}
+		if got := semantics.SourceError(err).Error(); got != ts.want {
+			t.Errorf("Test %d (PRINTF) failed.\nExpected: %q\nGot:      %q", i+1, ts.want, got)
+		}
+		if raw := err.Error(); strings.Count(raw, ": error: ") != 1 {
+			t.Errorf("Test %d (PRINTF) raw error has more than one position: %q", i+1, raw)
+		}
+	}
+}
+
+func TestTestBlocks(t *testing.T) {
+	src := `program Tested;
+	 var total: int;
+	 var nums: list of int;
+	 void add(n: int)[{
+		total += n;
+		append(nums, n);
+	 }];
+	 test "suma una vez" {
+		add(5);
+		assert(total == 5, "total debe ser 5");
+		assert(len(nums) == 1);
+	 };
+	 test "globales desde cero" {
+		var local := 1;
+		add(local);
+		assert(total == 1, "total no se reinicio");
+	 };
+	 test "falla" {
+		print("antes");
+		assert(total > 0, "total es cero");
+	 };
+	 void test()[{
+		print("funcion");
+	 }];
+	 main {
+		add(2);
+		test();
+		print(total);
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	// El programa normal se salta los tests
+	var out bytes.Buffer
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.Output = &out
+	if err := vm.Run(); err != nil || out.String() != "funcion\n2\n" {
+		t.Errorf("el programa no se ejecutó igual con tests: %q %v", out.String(), err)
+	}
+
+	// Cada test corre con globales nuevas; el que falla reporta posición y salida
+	var report bytes.Buffer
+	passed, failed := semantics.RunTests(&report)
+	if passed != 2 || failed != 1 {
+		t.Errorf("se esperaban 2 pasaron y 1 falló, fueron %d y %d:\n%s", passed, failed, report.String())
+	}
+	for _, want := range []string{"PASS  suma una vez", "FAIL  falla (línea 18)", "20:9:", "total es cero", `salida: "antes\n"`} {
+		if !strings.Contains(report.String(), want) {
+			t.Errorf("el reporte no contiene %q:\n%s", want, report.String())
+		}
+	}
+}
+
+func TestTestBlocksRunInit(t *testing.T) {
+	src := `program TestedInit;
+	 var rate: int;
+	 var nums: list of int;
+	 func fact(n: int): int[{
+		if (n <= 1) {
+			return 1;
+		};
+		return n * fact(n - 1);
+	 }];
+	 test "factorial of 5" {
+		assert(fact(5) == 120);
+	 };
+	 test "init ya corrio" {
+		assert(rate == 10, "rate sin init");
+		assert(len(nums) == 2, "nums sin init");
+		append(nums, 3);
+		rate += 1;
+	 };
+	 test "cada test tiene su init" {
+		assert(rate == 10, "rate del test anterior");
+		assert(len(nums) == 2, "nums del test anterior");
+	 };
+	 init {
+		var i := 0;
+		rate = 10;
+		append(nums, 1);
+		append(nums, 2);
+	 }
+	 main {
+		print(rate, nums);
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	var report bytes.Buffer
+	passed, failed := semantics.RunTests(&report)
+	if passed != 3 || failed != 0 {
+		t.Errorf("se esperaban 3 pasaron y 0 fallaron, fueron %d y %d:\n%s", passed, failed, report.String())
+	}
+}
+
+func TestBitOpsCheckType(t *testing.T) {
+	src := `program BitFloat;
+	 var i: int;
+	 var f: float;
+	 main {
+		f = 1.5;
+		print(i & 1);
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	// Fuerza un float en la dirección de i (la VM no debe confiar en el tipo del compilador)
+	addrOf := map[string]int{}
+	for addr, name := range semantics.AddressToName {
+		addrOf[name] = addr
+	}
+	for i, quad := range semantics.Quads {
+		if quad.Oper == semantics.ASSIGN && quad.Result == addrOf["f"] {
+			semantics.Quads[i].Result = addrOf["i"]
+		}
+	}
+
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.Output = &bytes.Buffer{}
+	err := vm.Run()
+	rtErr, ok := err.(*semantics.RuntimeError)
+	if !ok || rtErr.Code != semantics.ErrTypeMismatch || rtErr.Pos.Line != 6 {
+		t.Errorf("se esperaba un error de tipo en la línea 6, fue: %v", err)
+	}
+}
+
+func TestReturnInsideTry(t *testing.T) {
+	src := `program ReturnTry;
+	 var x, z: int;
+	 var q: float;
+	 func f(): int[{
+		try {
+			try {
+				return 1;
+			} catch (e) {
+				print("catch interno de f", e);
+			};
+		} catch (e) {
+			print("catch de f", e);
+		};
+		return 0;
+	 }];
+	 main {
+		x = f();
+		try {
+			q = 1 / z;
+		} catch (e) {
+			print("catch de main", e);
+		};
+		print(x);
+		q = 1 / z;
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	// El return quita los try de f: el error de main no regresa al catch de f
+	var out bytes.Buffer
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.Output = &out
+	err := vm.Run()
+	if out.String() != "catch de main -1\n1\n" {
+		t.Errorf("salida inesperada: %q", out.String())
+	}
+	rtErr, ok := err.(*semantics.RuntimeError)
+	if !ok || rtErr.Code != semantics.ErrDivisionByZero {
+		t.Errorf("se esperaba división entre cero, fue: %v", err)
+	}
+}
+
+func TestEndTryWithoutTry(t *testing.T) {
+	src := `program LoneEndTry;
+	 var z: int;
+	 main {
+		try {
+			z = 1;
+		} catch (e) {
+			print(e);
+		};
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	// Sin el TRY, el ENDTRY no tiene handler que quitar y debe ser un error interno, no un pánico recuperado
+	for i, quad := range semantics.Quads {
+		if quad.Oper == semantics.TRY {
+			semantics.Quads[i] = semantics.QuadStructure{Oper: semantics.GOTO, Left: "_", Right: "_", Result: i + 1}
+		}
+	}
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	err := vm.Run()
+	rtErr, ok := err.(*semantics.RuntimeError)
+	if !ok || rtErr.Code != semantics.ErrInternal || rtErr.Message != "ENDTRY sin try activo" {
+		t.Errorf("se esperaba error interno de ENDTRY, fue: %v", err)
+	}
+}
+
+func TestHeapCollect(t *testing.T) {
+	// Cada llamada crea una lista local que deja de usarse al regresar; la global vive todo el programa
+	src := `program Garbage;
+	 var keep: list of int;
+	 var i: int;
+	 void fill(n: int)[
+		var tmp: list of int;
+		{
+			append(tmp, n);
+			append(tmp, n * 2);
+		}
+	 ];
+	 main {
+		append(keep, 7);
+		while (i < 500) do {
+			fill(i);
+			i += 1;
+		};
+		print(keep);
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	var out bytes.Buffer
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.Output = &out
+	if err := vm.Run(); err != nil {
+		t.Fatalf("unexpected runtime error: %s", err.Error())
+	}
+	if out.String() != "[7]\n" {
+		t.Errorf("la lista global cambió después de recolectar: %q", out.String())
+	}
+
+	stats := vm.HeapStats()
+	if stats.Allocated != 501 || stats.Collections == 0 || stats.LiveObjects > 64 {
+		t.Errorf("estadísticas inesperadas del heap: %+v", stats)
+	}
+
+	// Una recolección al final solo deja la lista global
+	vm.LocalMemory = nil
+	vm.Collect()
+	if stats = vm.HeapStats(); stats.LiveObjects != 1 || stats.Freed != 500 {
+		t.Errorf("después de Collect quedaron objetos de más: %+v", stats)
+	}
+}
+
+func TestHeapCollectGenerators(t *testing.T) {
+	// Cada llamada crea una lista y un generador sobre ella; recolectar al crear el generador no debe liberar sus parámetros
+	src := `program GenGarbage;
+	 var total: int;
+	 var i: int;
+	 generator int walk(xs: list of int, scale: int) [
+		var k: int;
+		{
+			while (k < len(xs)) do {
+				yield xs[k] * scale;
+				k += 1;
+			};
+		}
+	 ];
+	 void fill(n: int)[
+		var tmp: list of int;
+		{
+			append(tmp, n);
+			append(tmp, 1);
+			for x in walk(tmp, 2) do {
+				total += x;
+			};
+		}
+	 ];
+	 main {
+		while (i < 300) do {
+			fill(i);
+			i += 1;
+		};
+		print(total);
+	 }
+	 end`
+
+	semantics.ResetSemanticState()
+	if _, err := parser.NewParser().Parse(lexer.NewLexer([]byte(src))); err != nil {
+		t.Fatalf("unexpected parse error: %s", err.Error())
+	}
+
+	var out bytes.Buffer
+	vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+	vm.Output = &out
+	if err := vm.Run(); err != nil {
+		t.Fatalf("unexpected runtime error: %s", err.Error())
+	}
+	// 2 * (0 + 1 + ... + 299) + 2 * 300
+	if out.String() != "90300\n" {
+		t.Errorf("salida inesperada con recolecciones: %q", out.String())
+	}
+
+	stats := vm.HeapStats()
+	if stats.Allocated != 600 || stats.Collections == 0 || stats.LiveObjects > 64 {
+		t.Errorf("estadísticas inesperadas del heap: %+v", stats)
+	}
+}
+
+// TO4: Programa con la salida que debe producir
+type TO4 struct {
+	src string
+	out string
+}
+
+var testDataOutput4 = []*TO4{
+	{
+		`program PrintLine;
+		 var n, resultado: int;
+		 main {
+			n = 10;
+			resultado = 55;
+			print("Fibonacci de", n, "es:", resultado);
+			print(n);
+		 }
+		 end`,
+		"Fibonacci de 10 es: 55\n10\n",
+	}, // Output 1: print en una sola línea separado por espacios
+	{
+		`program WriteLine;
+		 var i: int;
+		 main {
+			i = 0;
+			while (i < 3) do {
+				write(i, "");
+				i = i + 1;
+			};
+			print("fin");
+		 }
+		 end`,
+		"0 1 2 fin\n",
+	}, // Output 2: write sin salto de línea
+	{
+		`program Printf;
+		 var n: int;
+		 var avg: float;
+		 main {
+			n = 3;
+			avg = 10 / n;
+			printf("%d items, %.2f avg\n", n, avg);
+			printf("%5d|%-3d|100%%\n", n * 2, n);
+			printf("%.1f\t%v\n", n, avg > 3);
+		 }
+		 end`,
+		"3 items, 3.33 avg\n    6|3  |100%\n3.0\ttrue\n",
+	}, // Output 3: printf con verbos, ancho, precisión y escapes
+	{
+		`program Overloads;
+		 var r: float;
+		 void area(r: float)[{
+			printf("circulo %.2f\n", r * r * 3.14);
+		 }];
+		 void area(w: float, h: float)[{
+			printf("rectangulo %.1f\n", w * h);
+		 }];
+		 void area(s: int)[{
+			printf("cuadrado %d\n", s * s);
+		 }];
+		 main {
+			r = 2.0;
+			area(r);
+			area(3);
+			area(r, 3);
+		 }
+		 end`,
+		"circulo 12.56\ncuadrado 9\nrectangulo 6.0\n",
+	}, // Output 4: Cada llamada llega a la sobrecarga correcta
+	{
+		`program Defaults;
+		 void plot(x: int, y: int, color: int = 1, alpha: float = -0.5)[{
+			print(x, y, color, alpha);
+		 }];
+		 main {
+			plot(1, 2);
+			plot(y: 2, x: 1);
+			plot(3, 4, alpha: 1);
+			plot(5, color: 7, y: 6);
+		 }
+		 end`,
+		"1 2 1 -0.5\n1 2 1 -0.5\n3 4 1 1\n5 6 7 -0.5\n",
+	}, // Output 5: Valores por defecto y argumentos con nombre
+	{
+		`program Ternary;
+		 var a, b, m: int;
+		 var f: float;
+		 void show(v: int)[{
+			print(v);
+		 }];
+		 main {
+			a = 3;
+			b = 0;
+			m = a > b ? a : b;
+			f = b != 0 ? a / b : 0.5;
+			print(m, f, a < b ? 1 : 2);
+			show(a > 2 ? a * 10 : a);
+			m = a < 0 ? 0 - 1 : a < 5 ? 5 : 10;
+			print(m);
+		 }
+		 end`,
+		"3 0.5 2\n30\n5\n",
+	}, // Output 6: Condicional en asignación, argumentos y print, sin evaluar la rama que no toca
+	{
+		`program Enums;
+		 enum Color { Red, Green, Blue };
+		 enum State { Idle, Running };
+		 var c, d: Color;
+		 var s: State;
+		 var n: int;
+		 void show(col: Color)[{
+			print("color", col);
+		 }];
+		 main {
+			c = Color.Green;
+			print(c);
+			d = Color(int(c) + 1);
+			show(d);
+			if (c != d) {
+				print("distintos");
+			};
+			n = int(Color.Blue) * 10;
+			s = State.Running;
+			printf("%v=%d %v\n", c, int(c), s);
+			print(n);
+		 }
+		 end`,
+		"Green\ncolor Blue\ndistintos\nGreen=1 Running\n20\n",
+	}, // Output 7: Enums con conversión explícita, comparación y nombres al imprimir
+	{
+		`program Infer;
+		 enum Color { Red, Green };
+		 var n: int;
+		 void half(v: int)[{
+			var h := v / 2.0;
+			var k := v * 3;
+			print(h, k);
+		 }];
+		 main {
+			n = 4;
+			var total := n + 1;
+			var ratio := total / 2.0;
+			var c := Color.Green;
+			half(total);
+			print(total, ratio, c);
+		 }
+		 end`,
+		"2.5 15\n5 2.5 Green\n",
+	}, // Output 8: Tipo inferido en main y en función (int, float y enum)
+	{
+		`program Factorial;
+		 var i, n: int;
+		 var f, g: bigint;
+		 void show(v: bigint, scale: bigint = 1n)[{
+			print(v * scale);
+		 }];
+		 main {
+			n = 25;
+			f = 1;
+			i = 1;
+			while (i < n + 1) do {
+				f = f * i;
+				i += 1;
+			};
+			print(f);
+			g = bigint(n) * 4000000000000000000n;
+			printf("%d %d\n", g, g / 3);
+			show(f - f + 7, 3n);
+			show(5);
+			if (g > f) {
+				print("mayor");
+			};
+			var big := f + g;
+			print(big);
+		 }
+		 end`,
+		"15511210043330985984000000\n100000000000000000000 33333333333333333333\n21\n5\n15511310043330985984000000\n",
+	}, // Output 9: bigint sin desbordarse, literales, conversión desde int y parámetros
+	{
+		`program Bits;
+		 var a, b, n, count: int;
+		 main {
+			a = 12;
+			b = 10;
+			print(a & b, a | b, a xor b, ~a);
+			print(1 << 10, 1024 >> 3, a | b & 3 xor 1);
+			print((a & 4) != 0);
+			n = 45;
+			count = 0;
+			while (n != 0) do {
+				count += n & 1;
+				n = n >> 1;
+			};
+			print(count);
+		 }
+		 end`,
+		"8 14 6 -13\n1024 128 15\ntrue\n4\n",
+	}, // Output 10: Operadores de bits, precedencia como en C y conteo de bits
+	{
+		`program Lists;
+		 var nums: list of int;
+		 var fs: list of float;
+		 var total, i: int;
+		 void doubled(xs: list of int)[
+			var copy: list of int;
+			{
+				for x in xs do {
+					append(copy, x * 2);
+				};
+				append(xs, 100);
+				print(copy, len(copy));
+			}
+		 ];
+		 main {
+			i = 0;
+			while (i < 5) do {
+				append(nums, i * i);
+				i += 1;
+			};
+			print(nums, len(nums), nums[2] + nums[len(nums) - 1]);
+			nums[0] = 7;
+			doubled(nums);
+			print(nums);
+			append(fs, 1);
+			append(fs, 2.5);
+			fs[0] = fs[0] / 4;
+			for f in fs do {
+				write(f, "");
+			};
+			print("");
+			for n in nums do {
+				total += n;
+			};
+			print(total);
+		 }
+		 end`,
+		"[0, 1, 4, 9, 16] 5 20\n[14, 2, 8, 18, 32] 5\n[7, 1, 4, 9, 16, 100]\n0.25 2.5 \n137\n",
+	}, // Output 11: Listas con append, len, índices, for-in y paso por referencia
+	{
+		`program Maps;
+		 var ages: map[string]int;
+		 var prices: map[int]float;
+		 var name: string;
+		 main {
+			ages["ana"] = 20;
+			ages["luis"] = 31;
+			ages["eva"] = 7;
+			ages["ana"] = 21;
+			print(ages["ana"], len(ages), has(ages, "luis"));
+			delete(ages, "luis");
+			delete(ages, "nadie");
+			print(ages, has(ages, "luis"));
+			for k in ages do {
+				name = k + "!";
+				print(name, ages[k]);
+			};
+			prices[3] = 2;
+			prices[3] = prices[3] / 4;
+			print(prices);
+		 }
+		 end`,
+		"21 3 true\n{ana: 21, eva: 7} false\nana! 21\neva! 7\n{3: 0.5}\n",
+	}, // Output 12: Mapas con has, delete, len y recorrido en orden de inserción
+	{
+		`program StacksQueues;
+		 var s: stack of int;
+		 var q: queue of float;
+		 var x: int;
+		 main {
+			push(s, 1);
+			push(s, 2);
+			push(s, 3);
+			print(s, peek(s), size(s));
+			x = pop(s);
+			pop(s);
+			print(x, s, isEmpty(s));
+			enqueue(q, 1);
+			enqueue(q, 2.5);
+			print(dequeue(q) + 0.5, peek(q), size(q));
+			dequeue(q);
+			try {
+				x = pop(s) + pop(s);
+			} catch (e) {
+				print(e, isEmpty(s), isEmpty(q));
+			};
+		 }
+		 end`,
+		"[1, 2, 3] 3 3\n3 [1] false\n1.5 2.5 1\n-8 true true\n",
+	}, // Output 13: Pilas y colas con push/pop/peek/enqueue/dequeue y pila vacía atrapada
+	{
+		`program Generators;
+		 var total: int;
+		 generator int range(n: int) [
+			var i: int;
+			{
+				while (i < n) do {
+					yield i;
+					i += 1;
+				};
+			}
+		 ];
+		 generator float halves(n: int, step: float = 0.5) [
+			var x: float;
+			{
+				while (x < n) do {
+					yield x;
+					x = x + step;
+				};
+			}
+		 ];
+		 main {
+			for x in range(4) do {
+				for y in range(x) do {
+					write(y, "");
+				};
+				print("|");
+				total += x;
+			};
+			print(total);
+			for h in halves(2) do {
+				write(h, "");
+			};
+			print("");
+			for z in range(0) do {
+				print(z);
+			};
+		 }
+		 end`,
+		"|\n0 |\n0 1 |\n0 1 2 |\n6\n0 0.5 1 1.5 \n",
+	}, // Output 14: Generadores anidados, con valor por defecto y sin valores
+	{
+		`program Variadic;
+		 void sum(label: int, nums: float...)[
+			var total: float;
+			{
+				for n in nums do {
+					total = total + n;
+				};
+				print(label, len(nums), total);
+			}
+		 ];
+		 void sum(label: int, extra: int)[{
+			print("fija", label + extra);
+		 }];
+		 void join(parts: string...)[{
+			print(parts);
+		 }];
+		 main {
+			sum(1, 2, 3.5, 4);
+			sum(2);
+			sum(3, 4);
+			join("a", "b");
+		 }
+		 end`,
+		"1 3 9.5\n2 0 0\nfija 7\n[a, b]\n",
+	}, // Output 15: Funciones variádicas, con cero extras y junto a una sobrecarga fija
+	{
+		`program Interp;
+		 var n: int;
+		 var resultado: int;
+		 var nums: list of int;
+		 void fib(k: int)[
+			var a, b, t: int;
+			{
+				b = 1;
+				while (k > 0) do {
+					t = a + b;
+					a = b;
+					b = t;
+					k -= 1;
+				};
+				resultado = a;
+			}
+		 ];
+		 main {
+			n = 10;
+			fib(n);
+			append(nums, 1);
+			append(nums, 2);
+			print("Fibonacci de {n} es {resultado}");
+			print("{n / 4.0} {n > 3} {resultado + 1} {nums}");
+			print("{{literal}} {nums[0] * 7}");
+		 }
+		 end`,
+		"Fibonacci de 10 es 55\n2.5 true 56 [1, 2]\n{literal} 7\n",
+	}, // Output 16: Interpolación con expresiones, listas y llaves escapadas
+	{
+		`program Init;
+		 var base: int;
+		 var nums: list of int;
+		 void fill(k: int)[{
+			while (k > 0) do {
+				append(nums, k * base);
+				k -= 1;
+			};
+		 }];
+		 init {
+			var veces := 3;
+			base = 10;
+			fill(veces);
+			print("init");
+		 }
+		 main {
+			print(nums, base);
+		 }
+		 end`,
+		"init\n[30, 20, 10] 10\n",
+	}, // Output 17: Bloque init antes de main, con listas globales y llamadas
+	{
+		`program Returns;
+		 var q, r: int;
+		 var s: string;
+		 func minmax(a: int, b: int): (int, int)[{
+			if (a < b) {
+				return a, b;
+			};
+			return b, a;
+		 }];
+		 func fib(n: int): int[{
+			if (n < 2) {
+				return n;
+			};
+			return fib(n - 1) + fib(n - 2);
+		 }];
+		 func label(n: int): (string, float)[{
+			return "n{n}", n * 0.5;
+		 }];
+		 void early(n: int)[{
+			if (n > 1) {
+				return;
+			};
+			print("chico", n);
+		 }];
+		 main {
+			q, r = minmax(9, 4);
+			print(q, r);
+			print(fib(10), fib(fib(4)) + 1);
+			var f := 0.0;
+			s, f = label(3);
+			print(s, f);
+			early(1);
+			early(5);
+		 }
+		 end`,
+		"4 9\n55 3\nn3 1.5\nchico 1\n",
+	}, // Output 18: Funciones con uno y varios valores de retorno, recursión y return sin valor
+	{
+		`program Strings;
+		 enum Color { Red, Green };
+		 var s: string;
+		 var n: int;
+		 var nums: list of int;
+		 main {
+			s = "Hola Pato";
+			append(nums, 4);
+			print(len(s), substr(s, 5, 4), substr(s, 0, 0), indexOf(s, "Pato"), indexOf(s, "x"));
+			print(upper(s), lower(s));
+			n = toInt(" 42 ") + 1;
+			print(n, toFloat("2.5") * 2);
+			print(str(n) + "!", str(1.5), str(n > 3), str(Color.Green), str(nums), str(s));
+		 }
+		 end`,
+		"9 Pato  5 -1\nHOLA PATO hola pato\n43 5\n43! 1.5 true Green [4] Hola Pato\n",
+	}, // Output 19: Funciones predefinidas de strings
+	{
+		`program GenTry;
+		 var z: int;
+		 var q: float;
+		 generator int nums(n: int)[
+			var i: int;
+			{
+				while (i < n) do {
+					try {
+						yield i;
+						q = 1 / (i - 1);
+					} catch (e) {
+						print("catch del generador", e);
+					};
+					i += 1;
+				};
+			}
+		 ];
+		 main {
+			try {
+				for x in nums(3) do {
+					print("x", x);
+					if (x > 1) {
+						q = 1 / z;
+					};
+				};
+			} catch (e) {
+				print("catch de main", e);
+			};
+		 }
+		 end`,
+		"x 0\nx 1\ncatch del generador -1\nx 2\ncatch de main -1\n",
+	}, // Output 20: El try de un generador suspendido no atrapa errores del for-in
+	{
+		`program Truncate;
+		 var q, i: int;
+		 var f: float;
+		 main {
+			q = 7 / 2;
+			i = 5;
+			i /= 2;
+			f = 0 - 2.9;
+			print(q, i, i & 1, i << 1, int(2.9), int(f));
+		 }
+		 end`,
+		"3 2 0 4 2 -2\n",
+	}, // Output 21: Un float que se guarda en int se trunca (la memoria int solo tiene ints)
+	{
+		`program IndexCompound;
+		 var l: list of int;
+		 var ages: map[string]int;
+		 var calls, i: int;
+		 func next(): int[{
+			calls += 1;
+			return calls - 1;
+		 }];
+		 func bump(): int[{
+			i += 1;
+			return 1;
+		 }];
+		 main {
+			append(l, 1);
+			append(l, 2);
+			append(l, 3);
+			l[next()] += 10;
+			l[next()] *= 5;
+			l[i] += bump();
+			l[2] /= 2;
+			ages["ana"] = 20;
+			ages["ana"] -= 3;
+			print(l, calls, i, ages);
+		 }
+		 end`,
+		"[12, 10, 1] 2 1 {ana: 17}\n",
+	}, // Output 22: l[i] op= x evalúa el índice una sola vez, antes de la expresión de la derecha
+	{
+		`program ResultContracts;
+		 var q, r: int;
+		 func fact(n: int): int
+			requires n >= 0;
+			ensures result >= 1;
+		 [
+		 var result: int;
+		 {
+			result = 1;
+			while (n > 1) do {
+				result *= n;
+				n -= 1;
+			};
+			return result;
+		 }];
+		 func divmod(a: int, b: int): (int, int)
+			ensures result2 < b;
+			ensures result1 * b + result2 == a;
+		 [
+		 var d: int;
+		 {
+			d = a / b;
+			return d, a - d * b;
+		 }];
+		 main {
+			q, r = divmod(17, 5);
+			print(fact(5), q, r);
+		 }
+		 end`,
+		"120 3 2\n",
+	}, // Output 23: ensures lee result (o result1..N) y el cuerpo puede declarar su propio result
+	{
+		`program CatchMessage;
+		 var y: int;
+		 main {
+			try {
+				throw "sin saldo";
+			} catch (code, msg) {
+				print(code, msg);
+			};
+			try {
+				throw 7;
+			} catch (code, msg) {
+				print(code, msg);
+			};
+			try {
+				y = 1 / y;
+			} catch (code, msg) {
+				print(code, msg);
+			};
+			try {
+				throw 0 - 1;
+			} catch (code, msg) {
+				print(code, msg);
+			};
+		 }
+		 end`,
+		"0 sin saldo\n7 excepción 7\n-1 división entre cero\n-14 throw con código -1, los códigos de usuario deben ser positivos\n",
+	}, // Output 24: catch (code, msg) recibe el mensaje y un throw no puede usar códigos de la VM
+	{
+		`program Braces;
+		 var n: int;
+		 main {
+			n = 5;
+			print("{{}}", "{{", "}}", "set {{1, 2}}");
+			print("{{{n}}}");
+			printf("{n} %d\n", n);
+		 }
+		 end`,
+		"{} { } set {1, 2}\n{5}\n{n} 5\n",
+	}, // Output 25: Llaves literales con {{ y }} (el formato de printf no se interpola)
+	{
+		`program NestedCalls;
+		 var q, r: int;
+		 func g(n: int): int[{
+			return n * 10;
+		 }];
+		 func divmod(a: int, b: int): (int, int)[
+		 var d: int;
+		 {
+			d = a / b;
+			return d, a - d * b;
+		 }];
+		 void foo(a: int, b: int)[{
+			print("foo", a, b);
+		 }];
+		 void sum(nums: int...)[
+		 var total: int;
+		 {
+			for n in nums do {
+				total += n;
+			};
+			print("sum", total);
+		 }];
+		 main {
+			foo(g(1), 2);
+			q, r = divmod(g(6), 7);
+			print(q, r);
+			sum(1, g(2), 3);
+		 }
+		 end`,
+		"foo 10 2\n8 4\nsum 24\n",
+	}, // Output 26: Llamadas como estatuto, desestructuración y variádicas con una llamada como argumento
+	{
+		`program Comparisons;
+		 enum Color { Red, Green };
+		 var c: Color;
+		 var b: bigint;
+		 main {
+			c = Color.Green;
+			b = 5n;
+			print(3 == 3, 3 <= 2, 3 >= 3, 2.5 == 2.5, 2 <= 2.5);
+			print("ana" <= "eva", "eva" >= "luis", "x" == "x");
+			print(c == Color.Green, c <= Color.Red, b >= 5, b == 4n);
+		 }
+		 end`,
+		"true false true true true\ntrue false true\ntrue false true false\n",
+	}, // Output 27: ==, <= y >= con números, strings, enums y bigint
+	{
+		`program TernaryWiden;
+		 var n: int;
+		 var f: float;
+		 main {
+			n = 4611686018427387904;
+			f = n > 1 ? n : 0.5;
+			print(f * 4, (n < 1 ? 0.5 : n) * 4);
+			f = n;
+			print(f * 4);
+		 }
+		 end`,
+		"1.8446744073709552e+19 1.8446744073709552e+19\n1.8446744073709552e+19\n",
+	}, // Output 28: Rama int de ?: y asignación int a float guardan float (sin desbordar como int)
+}
+
+var testDataOutputFail4 = []*TI4{
+	{
+		`program BadVerb;
+		 var f: float;
+		 main {
+			printf("%d\n", f);
+		 }
+		 end`,
+	}, // Fail 1: %d con float
+	{
+		`program MissingArg;
+		 var n: int;
+		 main {
+			printf("%d y %d\n", n);
+		 }
+		 end`,
+	}, // Fail 2: Faltan argumentos
+	{
+		`program ExtraArg;
+		 var n: int;
+		 main {
+			printf("sin verbos\n", n);
+		 }
+		 end`,
+	}, // Fail 3: Sobran argumentos
+	{
+		`program UnknownVerb;
+		 var n: int;
+		 main {
+			printf("%q\n", n);
+		 }
+		 end`,
+	}, // Fail 4: Verbo desconocido
+	{
+		`program TernaryCond;
+		 var n: int;
+		 main {
+			n = n ? 1 : 2;
+		 }
+		 end`,
+	}, // Fail 5: Condición de ?: no booleana
+	{
+		`program TernaryBranches;
+		 var n: int;
+		 main {
+			print(n > 0 ? n : n < 1);
+		 }
+		 end`,
+	}, // Fail 6: Ramas de ?: con tipos incompatibles
+	{
+		`program EnumFromInt;
+		 enum Color { Red, Green };
+		 var c: Color;
+		 main {
+			c = 1;
+		 }
+		 end`,
+	}, // Fail 7: Int asignado a enum sin conversión
+	{
+		`program EnumArith;
+		 enum Color { Red, Green };
+		 var n: int;
+		 main {
+			n = Color.Green + 1;
+		 }
+		 end`,
+	}, // Fail 8: Aritmética entre enum e int sin conversión
+	{
+		`program EnumMix;
+		 enum Color { Red, Green };
+		 enum State { Idle, Running };
+		 var c: Color;
+		 main {
+			c = Color(State.Idle);
+		 }
+		 end`,
+	}, // Fail 9: Conversión entre enums distintos
+	{
+		`program EnumMember;
+		 enum Color { Red, Green };
+		 var c: Color;
+		 main {
+			c = Color.Blue;
+		 }
+		 end`,
+	}, // Fail 10: Miembro inexistente
+	{
+		`program InferBool;
+		 var n: int;
+		 main {
+			var b := n > 1;
+		 }
+		 end`,
+	}, // Fail 11: Tipo inferido que no se puede guardar (bool)
+	{
+		`program InferTwice;
+		 main {
+			var n := 1;
+			var n := 2;
+		 }
+		 end`,
+	}, // Fail 12: Variable inferida redeclarada
+	{
+		`program BigToInt;
+		 var n: int;
+		 var b: bigint;
+		 main {
+			n = b + 1;
+		 }
+		 end`,
+	}, // Fail 13: bigint asignado a int
+	{
+		`program BigFloat;
+		 var b: bigint;
+		 var f: float;
+		 main {
+			b = b * f;
+		 }
+		 end`,
+	}, // Fail 14: bigint con float
+	{
+		`program BigCast;
+		 var b: bigint;
+		 var f: float;
+		 main {
+			b = bigint(f);
+		 }
+		 end`,
+	}, // Fail 15: Conversión de float a bigint
+	{
+		`program BitFloat;
+		 var n: int;
+		 var f: float;
+		 main {
+			n = n & f;
+		 }
+		 end`,
+	}, // Fail 16: & con float
+	{
+		`program ShiftFloat;
+		 var n: int;
+		 main {
+			n = n << 1.5;
+		 }
+		 end`,
+	}, // Fail 17: Corrimiento con float
+	{
+		`program NotFloat;
+		 var f: float;
+		 main {
+			print(~f);
+		 }
+		 end`,
+	}, // Fail 18: ~ con float
+	{
+		`program BitPrecedence;
+		 var a: int;
+		 main {
+			print(a & 1 != 0);
+		 }
+		 end`,
+	}, // Fail 19: Como en C, & tiene menor precedencia que != (int & bool)
+	{
+		`program AppendFloat;
+		 var l: list of int;
+		 main {
+			append(l, 1.5);
+		 }
+		 end`,
+	}, // Fail 20: Agregar float a lista de int
+	{
+		`program FloatIndex;
+		 var l: list of int;
+		 main {
+			print(l[1.0]);
+		 }
+		 end`,
+	}, // Fail 21: Índice float
+	{
+		`program LenInt;
+		 var n: int;
+		 main {
+			print(len(n));
+		 }
+		 end`,
+	}, // Fail 22: len de un int
+	{
+		`program ListToInt;
+		 var l: list of int;
+		 var n: int;
+		 main {
+			n = l;
+		 }
+		 end`,
+	}, // Fail 23: Asignar lista a int
+	{
+		`program BuiltinName;
+		 void len(a: int)[{
+			print(a);
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 24: Función con nombre de función predefinida
+	{
+		`program AppendValue;
+		 var l: list of int;
+		 main {
+			print(append(l, 1));
+		 }
+		 end`,
+	}, // Fail 25: append no regresa valor
+	{
+		`program MapKey;
+		 var ages: map[string]int;
+		 main {
+			ages[1] = 20;
+		 }
+		 end`,
+	}, // Fail 26: Clave de tipo incorrecto
+	{
+		`program HasList;
+		 var l: list of int;
+		 var b: int;
+		 main {
+			if (has(l, 0)) {
+				b = 1;
+			};
+		 }
+		 end`,
+	}, // Fail 27: has sobre una lista
+	{
+		`program MapValue;
+		 var ages: map[string]int;
+		 main {
+			ages["ana"] = "veinte";
+		 }
+		 end`,
+	}, // Fail 28: Valor de tipo incorrecto
+	{
+		`program MapOfList;
+		 var m: map[string]list of int;
+		 main {
+		 }
+		 end`,
+	}, // Fail 29: Mapa con valores que no son int, float o string
+	{
+		`program PushQueue;
+		 var q: queue of int;
+		 main {
+			push(q, 1);
+		 }
+		 end`,
+	}, // Fail 30: push sobre una cola
+	{
+		`program PushFloat;
+		 var s: stack of int;
+		 main {
+			push(s, 1.5);
+		 }
+		 end`,
+	}, // Fail 31: float en una pila de int
+	{
+		`program YieldOutside;
+		 void f(n: int)[{
+			yield n;
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 32: yield fuera de un generador
+	{
+		`program YieldType;
+		 generator int g(n: int) [{
+			yield 1.5;
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 33: yield de un tipo que el generador no produce
+	{
+		`program GenStatement;
+		 generator int g(n: int) [{
+			yield n;
+		 }];
+		 main {
+			g(1);
+		 }
+		 end`,
+	}, // Fail 34: Generador llamado como estatuto
+	{
+		`program RequiresInt;
+		 void f(n: int)
+			requires n + 1;
+		 [{
+			print(n);
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 35: Condición de requires que no es booleana
+	{
+		`program VariadicType;
+		 void sum(nums: int...)[{
+			print(len(nums));
+		 }];
+		 main {
+			sum(1, 2.5);
+		 }
+		 end`,
+	}, // Fail 36: Argumento extra de tipo incorrecto
+	{
+		`program VariadicLast;
+		 void sum(nums: int..., label: int)[{
+			print(label);
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 37: Parámetro variádico que no es el último
+	{
+		`program NotTest;
+		 check "nombre" {
+			print(1);
+		 };
+		 main {
+		 }
+		 end`,
+	}, // Fail 38: Bloque con nombre que no es test
+	{
+		`program DupTest;
+		 test "a" {
+			print(1);
+		 };
+		 test "a" {
+			print(2);
+		 };
+		 main {
+		 }
+		 end`,
+	}, // Fail 39: Dos tests con el mismo nombre
+	{
+		`program InitRead;
+		 var base: int;
+		 var total: int;
+		 void add(k: int)[{
+			total = k * base;
+		 }];
+		 init {
+			add(2);
+			base = 10;
+		 }
+		 main {
+		 }
+		 end`,
+	}, // Fail 40: init llama a una función que lee una global antes de asignarla
+	{
+		`program Arity;
+		 var q: int;
+		 func divmod(a: int, b: int): (int, int)[{
+			return a, b;
+		 }];
+		 main {
+			q, q, q = divmod(7, 2);
+		 }
+		 end`,
+	}, // Fail 41: Se desestructuran tres variables de una función con dos valores
+	{
+		`program RetType;
+		 var q: int;
+		 var s: string;
+		 func divmod(a: int, b: int): (int, int)[{
+			return a, b;
+		 }];
+		 main {
+			q, s = divmod(7, 2);
+		 }
+		 end`,
+	}, // Fail 42: Un valor de retorno int asignado a un string
+	{
+		`program RetCount;
+		 func one(a: int): int[{
+			return a, a;
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 43: return con más valores de los que declara la función
+	{
+		`program TupleExpr;
+		 var q: int;
+		 func divmod(a: int, b: int): (int, int)[{
+			return a, b;
+		 }];
+		 main {
+			q = divmod(7, 2) + 1;
+		 }
+		 end`,
+	}, // Fail 44: Función con dos valores usada dentro de una expresión
+	{
+		`program MainReturn;
+		 main {
+			return 1;
+		 }
+		 end`,
+	}, // Fail 45: return fuera de una función
+	{
+		`program SubstrArgs;
+		 main {
+			print(substr("pato", 1.5, 2));
+		 }
+		 end`,
+	}, // Fail 46: substr con índice float
+	{
+		`program UpperInt;
+		 main {
+			print(upper(5));
+		 }
+		 end`,
+	}, // Fail 47: upper de un int
+	{
+		`program NoReturn;
+		 func two(): (int, int)[{
+			print("nada");
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 48: Función que regresa valores pero no tiene ningún return
+	{
+		`program RequiresResult;
+		 func twice(n: int): int
+			requires result > 0;
+		 [{
+			return n * 2;
+		 }];
+		 main {
+		 }
+		 end`,
+	}, // Fail 49: requires no puede usar result (todavía no hay valor de retorno)
+	{
+		`program CatchTypes;
+		 var msg: int;
+		 main {
+			try {
+				throw "x";
+			} catch (code, msg) {
+			};
+		 }
+		 end`,
+	}, // Fail 50: La variable del mensaje del catch ya existe y no es string
+	{
+		`program TwoInits;
+		 var n: int;
+		 init {
+			n = 1;
+		 }
+		 init {
+			n = 2;
+		 }
+		 main {
+			print(n);
+		 }
+		 end`,
+	}, // Fail 51: Un programa con dos bloques init
+	{
+		`program EnumConst;
+		 enum Color { Red, Green, Blue };
+		 var c: Color;
+		 main {
+			c = Color(7);
+		 }
+		 end`,
+	}, // Fail 52: Conversión a enum de una constante que no es miembro
+}
+
+func TestOutput(t *testing.T) {
+	p := parser.NewParser()
+	for i, ts := range testDataOutput4 {
+		semantics.ResetSemanticState()
+
+		s := lexer.NewLexer([]byte(ts.src))
+		_, err := p.Parse(s)
+		if err != nil {
+			t.Errorf("Test %d (OUTPUT) failed: unexpected parse error.\nError: %s", i+1, err.Error())
+			continue
+		}
+
+		// Captura la salida de la VM
+		var out bytes.Buffer
+		vm := semantics.NewVirtualMachine(semantics.Quads, semantics.FunctionDirectory)
+		vm.Output = &out
+		if err := vm.Run(); err != nil {
+			t.Errorf("Test %d (OUTPUT) failed: unexpected runtime error.\nError: %s", i+1, err.Error())
+			continue
+		}
+		if out.String() != ts.out {
+			t.Errorf("Test %d (OUTPUT) failed.\nExpected: %q\nGot:      %q", i+1, ts.out, out.String())
+		}
+	}
+}
+
+func TestOutputFail(t *testing.T) {
+	p := parser.NewParser()
+	for i, ts := range testDataOutputFail4 {
+		semantics.ResetSemanticState()
+
+		s := lexer.NewLexer([]byte(ts.src))
+		_, err := p.Parse(s)
+		if err == nil {
+			t.Errorf("Test %d (FAIL) did not produce expected error.\nSource start: %.50s...", i+1, ts.src)
+		} else {
+			t.Logf("Test %d (FAIL): Expected fail. Error: %s", i+1, semantics.SourceError(err).Error())
+		}
+	}
+}